@@ -58,6 +58,11 @@ func (r *httpRequest) Method() string {
 	return r.method
 }
 
+// GetBody returns the encoded body of the Request.
+func (r *httpRequest) GetBody() []byte {
+	return r.bodyBuilder.GetBody()
+}
+
 // Clone creates a new request containing the same data as this request
 func (r *httpRequest) Clone() driver.Request {
 	clone := *r
@@ -0,0 +1,230 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+// Package otelwrapper provides the connection wrapper which instruments the requests with OpenTelemetry traces and metrics.
+//
+// It is the separate module, so the OpenTelemetry dependencies are not added to the users of the driver
+// who do not need the instrumentation. The spans and the metrics are created by the instrumentation
+// of the v2 driver, so both drivers report the same telemetry.
+package otelwrapper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/arangodb/go-driver"
+	"github.com/arangodb/go-driver/v2/connection/otelwrapper"
+)
+
+const (
+	// ScopeName is the name of the tracer and the meter of the instrumentation.
+	ScopeName = "github.com/arangodb/go-driver/util/connection/wrappers/otelwrapper"
+
+	// DBSystem is the value of the `db.system` attribute.
+	DBSystem = otelwrapper.DBSystem
+
+	// MetricDuration is the name of the histogram of the request durations in seconds.
+	MetricDuration = otelwrapper.MetricDuration
+
+	// MetricRequestSize is the name of the histogram of the request body sizes in bytes.
+	MetricRequestSize = otelwrapper.MetricRequestSize
+
+	// MetricResponseSize is the name of the histogram of the response body sizes in bytes.
+	MetricResponseSize = otelwrapper.MetricResponseSize
+)
+
+// Options configures the instrumentation.
+type Options = otelwrapper.Options
+
+// NewConnection returns the connection which creates the client span per request, propagates the trace context
+// in the W3C `traceparent` header and records the duration and the body size histograms.
+//
+// The span follows the database semantic conventions: `db.system` is "arangodb", `db.name` is parsed from the
+// `/_db/{name}` prefix of the path, and `db.operation` is the method with the API path, e.g. "POST /_api/cursor".
+//
+// The request size is the size of the encoded request body. The response body is read by the connection
+// before the response is returned, so the response size is taken from the `Content-Length` header,
+// and it is not recorded when the server does not send the header, e.g. for the chunked responses.
+func NewConnection(c driver.Connection, opts Options) driver.Connection {
+	return &otelConnection{
+		connection:      c,
+		instrumentation: otelwrapper.NewInstrumentation(ScopeName, opts),
+	}
+}
+
+var _ driver.Connection = &otelConnection{}
+
+type otelConnection struct {
+	connection driver.Connection
+
+	instrumentation *otelwrapper.Instrumentation
+}
+
+func (o *otelConnection) NewRequest(method, path string) (driver.Request, error) {
+	r, err := o.connection.NewRequest(method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &otelRequest{Request: r}, nil
+}
+
+func (o *otelConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	var body interface{}
+	if r, ok := req.(*otelRequest); ok {
+		req = r.Request
+		body = r.body
+	}
+
+	ctx, finish := o.instrumentation.Start(ctx, otelwrapper.RequestInfo{
+		Method:  req.Method(),
+		Path:    req.Path(),
+		Body:    body,
+		Carrier: requestCarrier{request: req},
+	})
+
+	resp, err := o.connection.Do(ctx, req)
+	finish(responseInfo(req, resp), err)
+
+	return resp, err
+}
+
+func (o *otelConnection) Unmarshal(data driver.RawObject, result interface{}) error {
+	return o.connection.Unmarshal(data, result)
+}
+
+func (o *otelConnection) Endpoints() []string {
+	return o.connection.Endpoints()
+}
+
+func (o *otelConnection) UpdateEndpoints(endpoints []string) error {
+	return o.connection.UpdateEndpoints(endpoints)
+}
+
+func (o *otelConnection) SetAuthentication(authentication driver.Authentication) (driver.Connection, error) {
+	c, err := o.connection.SetAuthentication(authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	return &otelConnection{
+		connection:      c,
+		instrumentation: o.instrumentation,
+	}, nil
+}
+
+func (o *otelConnection) Protocols() driver.ProtocolSet {
+	return o.connection.Protocols()
+}
+
+var _ driver.Request = &otelRequest{}
+
+// otelRequest remembers the body of the request, so the query text can be added to the span.
+type otelRequest struct {
+	driver.Request
+
+	body interface{}
+}
+
+func (o *otelRequest) copyWith(r driver.Request) *otelRequest {
+	return &otelRequest{
+		Request: r,
+		body:    o.body,
+	}
+}
+
+func (o *otelRequest) SetQuery(key, value string) driver.Request {
+	return o.copyWith(o.Request.SetQuery(key, value))
+}
+
+func (o *otelRequest) SetBody(body ...interface{}) (driver.Request, error) {
+	r, err := o.Request.SetBody(body...)
+	if err != nil {
+		return nil, err
+	}
+
+	n := o.copyWith(r)
+	if len(body) > 0 {
+		n.body = body[0]
+	}
+	return n, nil
+}
+
+func (o *otelRequest) SetBodyArray(bodyArray interface{}, mergeArray []map[string]interface{}) (driver.Request, error) {
+	r, err := o.Request.SetBodyArray(bodyArray, mergeArray)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.copyWith(r), nil
+}
+
+func (o *otelRequest) SetBodyImportArray(bodyArray interface{}) (driver.Request, error) {
+	r, err := o.Request.SetBodyImportArray(bodyArray)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.copyWith(r), nil
+}
+
+func (o *otelRequest) SetHeader(key, value string) driver.Request {
+	return o.copyWith(o.Request.SetHeader(key, value))
+}
+
+func (o *otelRequest) Clone() driver.Request {
+	return o.copyWith(o.Request.Clone())
+}
+
+// responseInfo returns the result of the request for the instrumentation.
+func responseInfo(req driver.Request, resp driver.Response) otelwrapper.ResponseInfo {
+	info := otelwrapper.ResponseInfo{RequestSize: -1, ResponseSize: -1}
+	if getter, ok := req.(interface{ GetBody() []byte }); ok {
+		info.RequestSize = int64(len(getter.GetBody()))
+	}
+
+	if resp != nil {
+		info.StatusCode = resp.StatusCode()
+		info.Endpoint = resp.Endpoint()
+		if size, err := strconv.ParseInt(resp.Header("Content-Length"), 10, 64); err == nil {
+			info.ResponseSize = size
+		}
+	}
+
+	return info
+}
+
+// requestCarrier injects the trace context into the request headers.
+// Only Set is used by the propagators to inject the context, because the request does not expose its headers.
+type requestCarrier struct {
+	request driver.Request
+}
+
+func (r requestCarrier) Get(string) string {
+	return ""
+}
+
+func (r requestCarrier) Set(key, value string) {
+	r.request.SetHeader(key, value)
+}
+
+func (r requestCarrier) Keys() []string {
+	return nil
+}
@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package otelwrapper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/arangodb/go-driver"
+	driverhttp "github.com/arangodb/go-driver/http"
+)
+
+func Test_Connection(t *testing.T) {
+	received := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/_db/test/_api/version" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"result":[1]}`))
+	}))
+	defer server.Close()
+
+	base, err := driverhttp.NewConnection(driverhttp.ConnectionConfig{Endpoints: []string{server.URL}})
+	require.NoError(t, err)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	conn := NewConnection(base, Options{
+		TracerProvider:   sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		MeterProvider:    sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		CaptureQueryText: true,
+	})
+
+	ctx := context.Background()
+
+	t.Run("Cursor", func(t *testing.T) {
+		req, err := conn.NewRequest(http.MethodPost, "_db/test/_api/cursor")
+		require.NoError(t, err)
+		req, err = req.SetBody(map[string]interface{}{"query": "RETURN 1"})
+		require.NoError(t, err)
+		req = req.SetQuery("a", "b")
+
+		resp, err := conn.Do(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		headers := <-received
+		ended := spans.Ended()
+		require.Len(t, ended, 1)
+		span := ended[0]
+		assert.Equal(t, "POST /_api/cursor test", span.Name())
+		assert.Contains(t, headers.Get("traceparent"), span.SpanContext().TraceID().String())
+
+		attrs := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes() {
+			attrs[kv.Key] = kv.Value
+		}
+		assert.Equal(t, DBSystem, attrs[semconv.DBSystemKey].AsString())
+		assert.Equal(t, "test", attrs[semconv.DBNameKey].AsString())
+		assert.Equal(t, "RETURN 1", attrs[semconv.DBStatementKey].AsString())
+		assert.Equal(t, int64(http.StatusCreated), attrs[semconv.HTTPResponseStatusCodeKey].AsInt64())
+		assert.Equal(t, "127.0.0.1", attrs[semconv.ServerAddressKey].AsString())
+	})
+
+	t.Run("Error status", func(t *testing.T) {
+		req, err := conn.NewRequest(http.MethodGet, "_db/test/_api/version")
+		require.NoError(t, err)
+
+		resp, err := conn.Do(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+		<-received
+
+		ended := spans.Ended()
+		require.Len(t, ended, 2)
+		assert.Equal(t, codes.Error, ended[1].Status().Code)
+	})
+
+	t.Run("Authentication keeps instrumentation", func(t *testing.T) {
+		authenticated, err := conn.SetAuthentication(driver.BasicAuthentication("root", ""))
+		require.NoError(t, err)
+
+		req, err := authenticated.NewRequest(http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		_, err = authenticated.Do(ctx, req)
+		require.NoError(t, err)
+		<-received
+
+		require.Len(t, spans.Ended(), 3)
+	})
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	names := map[string]bool{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		names[m.Name] = true
+	}
+	assert.True(t, names[MetricDuration])
+	assert.True(t, names[MetricRequestSize])
+	assert.True(t, names[MetricResponseSize])
+}
@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+const (
+	// DefaultBulkWriterBatchSize is the default maximum number of the documents in one request.
+	DefaultBulkWriterBatchSize = 1000
+	// DefaultBulkWriterBatchBytes is the default maximum size of the documents in one request.
+	DefaultBulkWriterBatchBytes = 8 * 1024 * 1024
+	// DefaultBulkWriterWorkers is the default number of the concurrent requests.
+	DefaultBulkWriterWorkers = 4
+)
+
+// BulkWriter writes large numbers of the documents of type T to the collection.
+// In contrast to CreateDocuments, UpdateDocuments and ReplaceDocuments, which send all documents in one request,
+// the documents are split into batches by count and size, and the batches are sent concurrently.
+type BulkWriter[T any] interface {
+	// Write writes the documents of the slice.
+	// The results are in the order of the documents, so the result at index i is the result of documents[i].
+	// If the context is done, the documents which have not been written get the error of the context,
+	// and the error of the context is returned.
+	Write(ctx context.Context, documents []T) (BulkWriteResult, error)
+
+	// WriteChannel writes the documents received from the channel until it is closed.
+	// The documents are received only when a worker is free to send their batch, so a fast producer is slowed down
+	// instead of the documents being buffered in memory. A batch is sent when it is full or when the channel is closed.
+	// The results are in the order in which the documents have been received.
+	// If the context is done, the channel is not read anymore, and the error of the context is returned
+	// unless all documents have been written before.
+	WriteChannel(ctx context.Context, documents <-chan T) (BulkWriteResult, error)
+}
+
+// BulkWriteOperation is the operation of BulkWriter.
+type BulkWriteOperation string
+
+const (
+	// BulkWriteOperationCreate creates the documents (CreateDocuments).
+	BulkWriteOperationCreate BulkWriteOperation = "create"
+	// BulkWriteOperationUpdate updates the documents with given keys (UpdateDocuments).
+	BulkWriteOperationUpdate BulkWriteOperation = "update"
+	// BulkWriteOperationReplace replaces the documents with given keys (ReplaceDocuments).
+	BulkWriteOperationReplace BulkWriteOperation = "replace"
+)
+
+// BulkWriterOptions contains options for BulkWriter.
+type BulkWriterOptions struct {
+	// Operation is the operation used to write the documents. BulkWriteOperationCreate is used if it is empty.
+	Operation BulkWriteOperation
+
+	// BatchSize is the maximum number of the documents in one request. DefaultBulkWriterBatchSize is used if it is not set.
+	BatchSize int
+
+	// BatchBytes is the maximum size of the documents in one request, measured as JSON.
+	// A document which is larger than BatchBytes is sent alone. DefaultBulkWriterBatchBytes is used if it is not set.
+	// Each document is marshaled once to be measured, and once again when the request is encoded
+	// with the content type of the connection, which can be VelocyPack.
+	BatchBytes int
+
+	// Workers is the number of the concurrent requests. DefaultBulkWriterWorkers is used if it is not set.
+	Workers int
+
+	// WaitForSync waits until the documents have been synced to disk.
+	WaitForSync *bool
+
+	// OverwriteMode controls what happens if a document with the same key already exists.
+	// It can be set only for BulkWriteOperationCreate.
+	OverwriteMode *CollectionDocumentCreateOverwriteMode
+}
+
+// BulkWriteResult is the result of BulkWriter.
+type BulkWriteResult struct {
+	// Documents contains the results of the documents in the order of the input.
+	Documents []BulkWriteDocumentResult
+
+	// Errors is the number of the documents which have not been written.
+	Errors int
+}
+
+// BulkWriteDocumentResult is the result of a single document written by BulkWriter.
+type BulkWriteDocumentResult struct {
+	// Index is the index of the document in the input.
+	Index int
+
+	DocumentMeta
+
+	// Err is the error of the document, e.g. a shared.ArangoError if the document violates a unique constraint,
+	// or the error of the request which has contained the document.
+	Err error
+}
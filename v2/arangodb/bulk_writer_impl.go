@@ -0,0 +1,316 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// NewBulkWriter returns the BulkWriter of the documents of type T to the given collection.
+func NewBulkWriter[T any](col Collection, opts *BulkWriterOptions) BulkWriter[T] {
+	b := &bulkWriter[T]{
+		col: col,
+	}
+
+	if opts != nil {
+		b.opts = *opts
+	}
+
+	b.opts.setDefaults()
+
+	return b
+}
+
+func (o *BulkWriterOptions) setDefaults() {
+	if o.Operation == "" {
+		o.Operation = BulkWriteOperationCreate
+	}
+
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultBulkWriterBatchSize
+	}
+
+	if o.BatchBytes <= 0 {
+		o.BatchBytes = DefaultBulkWriterBatchBytes
+	}
+
+	if o.Workers <= 0 {
+		o.Workers = DefaultBulkWriterWorkers
+	}
+}
+
+func (o *BulkWriterOptions) validate() error {
+	switch o.Operation {
+	case BulkWriteOperationCreate:
+	case BulkWriteOperationUpdate, BulkWriteOperationReplace:
+		if o.OverwriteMode != nil {
+			return errors.WithStack(shared.InvalidArgumentError{Message: "overwrite mode can be set only for the create operation"})
+		}
+	default:
+		return errors.WithStack(shared.InvalidArgumentError{Message: fmt.Sprintf("unknown bulk write operation %q", o.Operation)})
+	}
+
+	return nil
+}
+
+var _ BulkWriter[any] = &bulkWriter[any]{}
+
+type bulkWriter[T any] struct {
+	col  Collection
+	opts BulkWriterOptions
+}
+
+// bulkBatch contains the documents of a single request and their indexes in the input.
+type bulkBatch[T any] struct {
+	indexes   []int
+	documents []T
+	bytes     int
+}
+
+func (b bulkBatch[T]) failed(err error) []BulkWriteDocumentResult {
+	results := make([]BulkWriteDocumentResult, len(b.indexes))
+	for i, index := range b.indexes {
+		results[i] = BulkWriteDocumentResult{Index: index, Err: err}
+	}
+
+	return results
+}
+
+func (b *bulkWriter[T]) Write(ctx context.Context, documents []T) (BulkWriteResult, error) {
+	input := make(chan T)
+	stop := make(chan struct{})
+
+	go func() {
+		defer close(input)
+
+		for _, document := range documents {
+			select {
+			case input <- document:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	result, err := b.WriteChannel(ctx, input)
+	close(stop)
+
+	// The documents have been received in the order of the slice, so the missing results are at the end.
+	for i := len(result.Documents); i < len(documents) && err != nil; i++ {
+		result.Documents = append(result.Documents, BulkWriteDocumentResult{Index: i, Err: err})
+		result.Errors++
+	}
+
+	return result, err
+}
+
+func (b *bulkWriter[T]) WriteChannel(ctx context.Context, documents <-chan T) (BulkWriteResult, error) {
+	if err := b.opts.validate(); err != nil {
+		return BulkWriteResult{}, err
+	}
+
+	var lock sync.Mutex
+	var results []BulkWriteDocumentResult
+	addResults := func(r []BulkWriteDocumentResult) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		results = append(results, r...)
+	}
+
+	// The channel is not buffered, so the batches are not created faster than the workers send them.
+	batches := make(chan bulkBatch[T])
+
+	var wg sync.WaitGroup
+	for i := 0; i < b.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for batch := range batches {
+				addResults(b.writeBatch(ctx, batch))
+			}
+		}()
+	}
+
+	err := b.splitBatches(ctx, documents, batches, addResults)
+	close(batches)
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Index < results[j].Index
+	})
+
+	result := BulkWriteResult{Documents: results}
+	for _, r := range results {
+		if r.Err != nil {
+			result.Errors++
+
+			// The context can be done after all documents have been written, so its error is returned
+			// only if some document has failed because of it.
+			if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(r.Err, ctxErr) {
+				err = ctxErr
+			}
+		}
+	}
+
+	return result, err
+}
+
+// splitBatches reads the documents and sends them to the workers in batches.
+// The documents which can not be sent get the error in the results.
+// It returns the error of the context if the channel has not been read until it was closed.
+func (b *bulkWriter[T]) splitBatches(ctx context.Context, documents <-chan T, batches chan<- bulkBatch[T], addResults func([]BulkWriteDocumentResult)) error {
+	var batch bulkBatch[T]
+	index := 0
+
+	send := func() bool {
+		if len(batch.documents) == 0 {
+			return true
+		}
+
+		select {
+		case batches <- batch:
+			batch = bulkBatch[T]{}
+			return true
+		case <-ctx.Done():
+			addResults(batch.failed(ctx.Err()))
+			return false
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			addResults(batch.failed(ctx.Err()))
+
+			// The channel can be closed at the same time, then all documents have been received.
+			select {
+			case _, ok := <-documents:
+				if !ok {
+					return nil
+				}
+				addResults([]BulkWriteDocumentResult{{Index: index, Err: ctx.Err()}})
+			default:
+			}
+			return ctx.Err()
+		case document, ok := <-documents:
+			if !ok {
+				if !send() {
+					return ctx.Err()
+				}
+				return nil
+			}
+
+			data, err := json.Marshal(document)
+			if err != nil {
+				addResults([]BulkWriteDocumentResult{{Index: index, Err: errors.WithStack(err)}})
+				index++
+				continue
+			}
+
+			// The document which does not fit into the current batch starts the next one.
+			if len(batch.documents) > 0 && batch.bytes+len(data) > b.opts.BatchBytes {
+				if !send() {
+					addResults([]BulkWriteDocumentResult{{Index: index, Err: ctx.Err()}})
+					return ctx.Err()
+				}
+			}
+
+			batch.indexes = append(batch.indexes, index)
+			batch.documents = append(batch.documents, document)
+			batch.bytes += len(data)
+			index++
+
+			if len(batch.documents) >= b.opts.BatchSize {
+				if !send() {
+					return ctx.Err()
+				}
+			}
+		}
+	}
+}
+
+// writeBatch sends the batch with a single request and returns the results of its documents.
+func (b *bulkWriter[T]) writeBatch(ctx context.Context, batch bulkBatch[T]) []BulkWriteDocumentResult {
+	var read func() (DocumentMeta, error)
+
+	switch b.opts.Operation {
+	case BulkWriteOperationUpdate:
+		reader, err := b.col.UpdateDocumentsWithOptions(ctx, batch.documents, &CollectionDocumentUpdateOptions{
+			WithWaitForSync: b.opts.WaitForSync,
+		})
+		if err != nil {
+			return batch.failed(err)
+		}
+
+		read = func() (DocumentMeta, error) {
+			response, err := reader.Read()
+			return response.DocumentMeta, err
+		}
+	case BulkWriteOperationReplace:
+		reader, err := b.col.ReplaceDocumentsWithOptions(ctx, batch.documents, &CollectionDocumentReplaceOptions{
+			WithWaitForSync: b.opts.WaitForSync,
+		})
+		if err != nil {
+			return batch.failed(err)
+		}
+
+		read = func() (DocumentMeta, error) {
+			response, err := reader.Read()
+			return response.DocumentMeta, err
+		}
+	default:
+		reader, err := b.col.CreateDocumentsWithOptions(ctx, batch.documents, &CollectionDocumentCreateOptions{
+			WithWaitForSync: b.opts.WaitForSync,
+			OverwriteMode:   b.opts.OverwriteMode,
+		})
+		if err != nil {
+			return batch.failed(err)
+		}
+
+		read = func() (DocumentMeta, error) {
+			response, err := reader.Read()
+			return response.DocumentMeta, err
+		}
+	}
+
+	results := batch.failed(nil)
+	for i := range results {
+		meta, err := read()
+		if shared.IsNoMoreDocuments(err) {
+			err = errors.Errorf("missing result of the document")
+		}
+
+		results[i].DocumentMeta = meta
+		results[i].Err = err
+	}
+
+	return results
+}
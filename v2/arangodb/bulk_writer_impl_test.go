@@ -0,0 +1,286 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+type bulkTestDocument struct {
+	Key  string `json:"_key"`
+	Fail bool   `json:"fail,omitempty"`
+	Data string `json:"data,omitempty"`
+}
+
+// bulkTestServer answers the multi-document requests. The documents with the Fail flag violate a unique constraint.
+type bulkTestServer struct {
+	testRequestLog
+
+	delay time.Duration
+
+	lock      sync.Mutex
+	batches   [][]string
+	queries   []string
+	inFlight  int32
+	maxFlight int32
+}
+
+func (s *bulkTestServer) collection(t *testing.T) Collection {
+	return newCollection(newTestDatabase(t, s), "col")
+}
+
+func (s *bulkTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.add(r)
+	flight := atomic.AddInt32(&s.inFlight, 1)
+	defer atomic.AddInt32(&s.inFlight, -1)
+
+	var documents []bulkTestDocument
+	if r.URL.Path != "/_db/db/_api/document/col" || json.NewDecoder(r.Body).Decode(&documents) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	keys := make([]string, len(documents))
+	for i, d := range documents {
+		keys[i] = d.Key
+	}
+
+	s.lock.Lock()
+	s.batches = append(s.batches, keys)
+	s.queries = append(s.queries, r.URL.RawQuery)
+	if flight > s.maxFlight {
+		s.maxFlight = flight
+	}
+	s.lock.Unlock()
+
+	time.Sleep(s.delay)
+
+	var response []interface{}
+	for _, d := range documents {
+		if d.Fail {
+			response = append(response, map[string]interface{}{
+				"error": true, "code": http.StatusConflict, "errorNum": shared.ErrArangoUniqueConstraintViolated,
+				"errorMessage": "unique constraint violated",
+			})
+			continue
+		}
+
+		response = append(response, map[string]interface{}{"_key": d.Key, "_id": "col/" + d.Key, "_rev": "r" + d.Key})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func bulkTestDocuments(n int) []bulkTestDocument {
+	documents := make([]bulkTestDocument, n)
+	for i := range documents {
+		documents[i] = bulkTestDocument{Key: string(rune('a' + i))}
+	}
+	return documents
+}
+
+func TestBulkWriterWrite(t *testing.T) {
+	s := &bulkTestServer{delay: 20 * time.Millisecond}
+	col := s.collection(t)
+
+	documents := bulkTestDocuments(10)
+	documents[3].Fail = true
+	documents[8].Fail = true
+
+	writer := NewBulkWriter[bulkTestDocument](col, &BulkWriterOptions{
+		BatchSize:     3,
+		Workers:       2,
+		WaitForSync:   utils.NewType(true),
+		OverwriteMode: utils.NewType(CollectionDocumentCreateOverwriteModeReplace),
+	})
+
+	result, err := writer.Write(context.Background(), documents)
+	require.NoError(t, err)
+	require.Len(t, result.Documents, 10)
+	assert.Equal(t, 2, result.Errors)
+
+	for i, r := range result.Documents {
+		assert.Equal(t, i, r.Index)
+		if documents[i].Fail {
+			assert.True(t, shared.IsArangoErrorWithErrorNum(r.Err, shared.ErrArangoUniqueConstraintViolated), r.Err)
+			continue
+		}
+		require.NoError(t, r.Err)
+		assert.Equal(t, documents[i].Key, r.Key)
+		assert.Equal(t, "col/"+documents[i].Key, string(r.ID))
+	}
+
+	assert.Len(t, s.batches, 4)
+	assert.Equal(t, 4, s.count("POST /_db/db/_api/document/col"))
+	assert.Len(t, s.all(), 4)
+	assert.EqualValues(t, 2, s.maxFlight)
+	for _, q := range s.queries {
+		assert.Contains(t, q, "waitForSync=true")
+		assert.Contains(t, q, "overwriteMode=replace")
+	}
+}
+
+func TestBulkWriterBatchBytes(t *testing.T) {
+	s := &bulkTestServer{}
+	col := s.collection(t)
+
+	documents := bulkTestDocuments(5)
+	documents[2].Data = strings.Repeat("x", 100)
+
+	writer := NewBulkWriter[bulkTestDocument](col, &BulkWriterOptions{
+		Operation:  BulkWriteOperationUpdate,
+		BatchBytes: 40,
+		Workers:    1,
+	})
+
+	result, err := writer.Write(context.Background(), documents)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Errors)
+
+	// Two small documents fit into a batch, the large document is sent alone.
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}, {"d", "e"}}, s.batches)
+	assert.Equal(t, 3, s.count("PATCH /_db/db/_api/document/col"))
+	assert.Len(t, s.all(), 3)
+}
+
+func TestBulkWriterWriteChannel(t *testing.T) {
+	s := &bulkTestServer{delay: 50 * time.Millisecond}
+	col := s.collection(t)
+
+	writer := NewBulkWriter[bulkTestDocument](col, &BulkWriterOptions{
+		Operation: BulkWriteOperationReplace,
+		BatchSize: 2,
+		Workers:   1,
+	})
+
+	input := make(chan bulkTestDocument)
+	var sent int32
+	go func() {
+		defer close(input)
+
+		for _, d := range bulkTestDocuments(8) {
+			input <- d
+			atomic.AddInt32(&sent, 1)
+		}
+	}()
+
+	type writeResult struct {
+		result BulkWriteResult
+		err    error
+	}
+	done := make(chan writeResult)
+	go func() {
+		result, err := writer.WriteChannel(context.Background(), input)
+		done <- writeResult{result, err}
+	}()
+
+	// The worker sends the first batch and the second batch waits for it,
+	// so the producer is blocked instead of all documents being read.
+	time.Sleep(25 * time.Millisecond)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&sent))
+
+	r := <-done
+	require.NoError(t, r.err)
+	require.Len(t, r.result.Documents, 8)
+	assert.Equal(t, "h", r.result.Documents[7].Key)
+	assert.Equal(t, 4, s.count("PUT /_db/db/_api/document/col"))
+	assert.Len(t, s.all(), 4)
+}
+
+func TestBulkWriterContextDone(t *testing.T) {
+	s := &bulkTestServer{delay: 100 * time.Millisecond}
+	col := s.collection(t)
+
+	writer := NewBulkWriter[bulkTestDocument](col, &BulkWriterOptions{BatchSize: 1, Workers: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	result, err := writer.Write(ctx, bulkTestDocuments(5))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, result.Documents, 5)
+	assert.Equal(t, 5, result.Errors)
+	for i, r := range result.Documents {
+		assert.Equal(t, i, r.Index)
+		assert.Error(t, r.Err)
+	}
+}
+
+// cancellingCollection cancels the context after the documents have been created.
+type cancellingCollection struct {
+	Collection
+	cancel context.CancelFunc
+}
+
+func (c cancellingCollection) CreateDocumentsWithOptions(ctx context.Context, documents interface{},
+	opts *CollectionDocumentCreateOptions) (CollectionDocumentCreateResponseReader, error) {
+	defer c.cancel()
+	return c.Collection.CreateDocumentsWithOptions(ctx, documents, opts)
+}
+
+func TestBulkWriterContextDoneAfterWrite(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	col := cancellingCollection{Collection: (&bulkTestServer{}).collection(t), cancel: cancel}
+	writer := NewBulkWriter[bulkTestDocument](col, nil)
+
+	documents := make(chan bulkTestDocument, 3)
+	for _, d := range bulkTestDocuments(3) {
+		documents <- d
+	}
+	close(documents)
+
+	// All documents have been written, so the context done afterwards is not an error.
+	result, err := writer.WriteChannel(ctx, documents)
+	require.NoError(t, err)
+	require.Error(t, ctx.Err())
+	require.Len(t, result.Documents, 3)
+	assert.Zero(t, result.Errors)
+}
+
+func TestBulkWriterInvalidOptions(t *testing.T) {
+	col := (&bulkTestServer{}).collection(t)
+
+	_, err := NewBulkWriter[bulkTestDocument](col, &BulkWriterOptions{
+		Operation:     BulkWriteOperationUpdate,
+		OverwriteMode: utils.NewType(CollectionDocumentCreateOverwriteModeIgnore),
+	}).Write(context.Background(), bulkTestDocuments(1))
+	require.True(t, shared.IsInvalidArgument(err))
+
+	_, err = NewBulkWriter[bulkTestDocument](col, &BulkWriterOptions{Operation: "delete"}).Write(context.Background(), nil)
+	require.True(t, shared.IsInvalidArgument(err))
+}
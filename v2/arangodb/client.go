@@ -33,4 +33,6 @@ type Client interface {
 	ClientServerInfo
 	ClientAdmin
 	ClientAsyncJob
+	ClientReplication
+	ClientTasks
 }
@@ -29,6 +29,7 @@ type ClientAdmin interface {
 	ClientAdminBackup
 	ClientAdminLicense
 	ClientAdminCluster
+	ClientAdminMetrics
 
 	// ServerMode returns the current mode in which the server/cluster is operating.
 	// This call needs ArangoDB 3.3 and up.
@@ -43,6 +44,14 @@ type ClientAdmin interface {
 	// Use ClientAdminCluster.Health() to fetch the Endpoint list.
 	// For ActiveFailover, it will return an error (503 code) if the server is not the leader.
 	CheckAvailability(ctx context.Context, serverEndpoint string) error
+
+	// Shutdown shuts down the server, optionally removing it from its cluster.
+	// With the Soft option, the coordinator is shut down gracefully and ShutdownInfo can be used to check the progress.
+	Shutdown(ctx context.Context, opts *ShutdownOptions) error
+
+	// ShutdownInfo returns information about the progress of a soft shutdown.
+	// This call needs ArangoDB 3.7.12, 3.8.1, 3.9.0 and up.
+	ShutdownInfo(ctx context.Context) (ShutdownInfo, error)
 }
 
 type ClientAdminLog interface {
@@ -51,6 +60,24 @@ type ClientAdminLog interface {
 
 	// SetLogLevels sets log levels for a given topics.
 	SetLogLevels(ctx context.Context, logLevels LogLevels, opts *LogLevelsSetOptions) error
+
+	// Logs returns the log entries of the server.
+	// This call needs ArangoDB 3.8 and up.
+	Logs(ctx context.Context, opts *LogEntriesOptions) (ServerLogs, error)
+}
+
+type ClientAdminMetrics interface {
+	// Metrics returns the metrics of the server parsed from the Prometheus text format.
+	Metrics(ctx context.Context) (Metrics, error)
+
+	// MetricsForSingleServer returns the metrics of the specific server parsed from the Prometheus text format.
+	// The serverID is only meaningful on Coordinators.
+	MetricsForSingleServer(ctx context.Context, serverID ServerID) (Metrics, error)
+
+	// Statistics returns the statistics of the server.
+	//
+	// Deprecated: Use Metrics instead.
+	Statistics(ctx context.Context) (ServerStatistics, error)
 }
 
 type ClientAdminLicense interface {
@@ -172,3 +172,79 @@ func (c *clientAdmin) CheckAvailability(ctx context.Context, serverEndpoint stri
 	_, err = c.client.Connection().Do(ctx, req, nil, http.StatusOK)
 	return errors.WithStack(err)
 }
+
+// ShutdownOptions describes the options of the server shutdown.
+type ShutdownOptions struct {
+	// RemoveFromCluster removes the server from its cluster.
+	RemoveFromCluster bool
+	// Soft shuts down the coordinator gracefully, see ShutdownInfo.
+	// This option needs ArangoDB 3.7.12, 3.8.1, 3.9.0 and up.
+	Soft bool
+}
+
+func (s *ShutdownOptions) modifyRequest(r connection.Request) error {
+	if s == nil {
+		return nil
+	}
+
+	if s.RemoveFromCluster {
+		r.AddQuery("remove_from_cluster", "1")
+	}
+
+	if s.Soft {
+		r.AddQuery("soft", "true")
+	}
+
+	return nil
+}
+
+// ShutdownInfo describes the progress of a soft shutdown.
+type ShutdownInfo struct {
+	// AQLCursors stores a number of AQL cursors that are still active.
+	AQLCursors int `json:"AQLcursors"`
+	// Transactions stores a number of ongoing transactions.
+	Transactions int `json:"transactions"`
+	// PendingJobs stores a number of ongoing asynchronous requests.
+	PendingJobs int `json:"pendingJobs"`
+	// DoneJobs stores a number of finished asynchronous requests, whose result has not yet been collected.
+	DoneJobs int `json:"doneJobs"`
+	// PregelConductors stores a number of ongoing Pregel jobs.
+	PregelConductors int `json:"pregelConductors"`
+	// LowPrioOngoingRequests stores a number of ongoing low priority requests.
+	LowPrioOngoingRequests int `json:"lowPrioOngoingRequests"`
+	// LowPrioQueuedRequests stores a number of queued low priority requests.
+	LowPrioQueuedRequests int `json:"lowPrioQueuedRequests"`
+	// AllClear is set if all operations are closed.
+	AllClear bool `json:"allClear"`
+	// SoftShutdownOngoing describes whether a soft shutdown of the Coordinator is in progress.
+	SoftShutdownOngoing bool `json:"softShutdownOngoing"`
+}
+
+func (c *clientAdmin) Shutdown(ctx context.Context, opts *ShutdownOptions) error {
+	url := connection.NewUrl("_admin", "shutdown")
+
+	_, err := connection.CallWithChecks(ctx, c.client.connection, http.MethodDelete, url, nil, []int{http.StatusOK},
+		opts.modifyRequest)
+	return errors.WithStack(err)
+}
+
+func (c *clientAdmin) ShutdownInfo(ctx context.Context) (ShutdownInfo, error) {
+	url := connection.NewUrl("_admin", "shutdown")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ShutdownInfo          `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return ShutdownInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ShutdownInfo, nil
+	default:
+		return ShutdownInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
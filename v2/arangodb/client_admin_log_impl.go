@@ -23,6 +23,7 @@ package arangodb
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/pkg/errors"
 
@@ -98,3 +99,101 @@ func (c *clientAdmin) SetLogLevels(ctx context.Context, logLevels LogLevels, opt
 		return response.AsArangoErrorWithCode(code)
 	}
 }
+
+// LogEntriesOptions describes the options of the log entries retrieval.
+type LogEntriesOptions struct {
+	// Upto returns the entries up to the given level (inclusive), e.g. "warning" returns fatal, error and warning entries.
+	Upto string
+	// Level returns the entries of the given level only. It can not be used together with Upto.
+	Level string
+	// Start returns the entries with an ID greater or equal to the given value.
+	Start *int
+	// Size restricts the result to at most the given number of entries.
+	Size *int
+	// Offset skips the given number of entries for the pagination.
+	Offset *int
+	// Search returns only the entries containing the given text.
+	Search string
+	// Sort orders the entries by their ID, either "asc" or "desc".
+	Sort string
+	// ServerID returns the log entries of a specific server. It is only meaningful on Coordinators.
+	ServerID ServerID
+}
+
+func (l *LogEntriesOptions) modifyRequest(r connection.Request) error {
+	if l == nil {
+		return nil
+	}
+
+	if l.Upto != "" {
+		r.AddQuery("upto", l.Upto)
+	}
+
+	if l.Level != "" {
+		r.AddQuery("level", l.Level)
+	}
+
+	if l.Start != nil {
+		r.AddQuery("start", strconv.Itoa(*l.Start))
+	}
+
+	if l.Size != nil {
+		r.AddQuery("size", strconv.Itoa(*l.Size))
+	}
+
+	if l.Offset != nil {
+		r.AddQuery("offset", strconv.Itoa(*l.Offset))
+	}
+
+	if l.Search != "" {
+		r.AddQuery("search", l.Search)
+	}
+
+	if l.Sort != "" {
+		r.AddQuery("sort", l.Sort)
+	}
+
+	if l.ServerID != "" {
+		r.AddQuery("serverId", string(l.ServerID))
+	}
+
+	return nil
+}
+
+// ServerLogs contains the log entries of the server.
+type ServerLogs struct {
+	// Total is the number of all log entries matching the criteria (before the pagination).
+	Total    int                `json:"total"`
+	Messages []ServerLogMessage `json:"messages,omitempty"`
+}
+
+// ServerLogMessage is a single log entry.
+type ServerLogMessage struct {
+	ID      int    `json:"id"`
+	Topic   string `json:"topic"`
+	Level   string `json:"level"`
+	Date    string `json:"date"`
+	Message string `json:"message"`
+}
+
+// Logs returns the log entries of the server.
+func (c *clientAdmin) Logs(ctx context.Context, opts *LogEntriesOptions) (ServerLogs, error) {
+	url := connection.NewUrl("_admin", "log", "entries")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ServerLogs            `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response, opts.modifyRequest)
+	if err != nil {
+		return ServerLogs{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ServerLogs, nil
+	default:
+		return ServerLogs{}, response.AsArangoErrorWithCode(code)
+	}
+}
@@ -0,0 +1,186 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// ServerStatistics contains statistical data about the server as a whole.
+type ServerStatistics struct {
+	Time       float64     `json:"time"`
+	Enabled    bool        `json:"enabled"`
+	System     SystemStats `json:"system"`
+	Client     ClientStats `json:"client"`
+	ClientUser ClientStats `json:"clientUser,omitempty"`
+	HTTP       HTTPStats   `json:"http"`
+	Server     ServerStats `json:"server"`
+}
+
+// SystemStats contains statistical data about the system, this is part of ServerStatistics.
+type SystemStats struct {
+	MinorPageFaults     int64   `json:"minorPageFaults"`
+	MajorPageFaults     int64   `json:"majorPageFaults"`
+	UserTime            float64 `json:"userTime"`
+	SystemTime          float64 `json:"systemTime"`
+	NumberOfThreads     int64   `json:"numberOfThreads"`
+	ResidentSize        int64   `json:"residentSize"`
+	ResidentSizePercent float64 `json:"residentSizePercent"`
+	VirtualSize         int64   `json:"virtualSize"`
+}
+
+// Stats is used for various time-related statistics.
+type Stats struct {
+	Sum    float64 `json:"sum"`
+	Count  int64   `json:"count"`
+	Counts []int64 `json:"counts"`
+}
+
+// ClientStats contains statistics about the client connections.
+type ClientStats struct {
+	HTTPConnections int64 `json:"httpConnections"`
+	ConnectionTime  Stats `json:"connectionTime"`
+	TotalTime       Stats `json:"totalTime"`
+	RequestTime     Stats `json:"requestTime"`
+	QueueTime       Stats `json:"queueTime"`
+	IoTime          Stats `json:"ioTime"`
+	BytesSent       Stats `json:"bytesSent"`
+	BytesReceived   Stats `json:"bytesReceived"`
+}
+
+// HTTPStats contains statistics about the HTTP traffic.
+type HTTPStats struct {
+	RequestsTotal     int64 `json:"requestsTotal"`
+	RequestsAsync     int64 `json:"requestsAsync"`
+	RequestsGet       int64 `json:"requestsGet"`
+	RequestsHead      int64 `json:"requestsHead"`
+	RequestsPost      int64 `json:"requestsPost"`
+	RequestsPut       int64 `json:"requestsPut"`
+	RequestsPatch     int64 `json:"requestsPatch"`
+	RequestsDelete    int64 `json:"requestsDelete"`
+	RequestsOptions   int64 `json:"requestsOptions"`
+	RequestsOther     int64 `json:"requestsOther"`
+	RequestsSuperuser int64 `json:"requestsSuperuser,omitempty"`
+	RequestsUser      int64 `json:"requestsUser,omitempty"`
+}
+
+// TransactionStats contains statistics about transactions.
+type TransactionStats struct {
+	Started             int64 `json:"started"`
+	Aborted             int64 `json:"aborted"`
+	Committed           int64 `json:"committed"`
+	IntermediateCommits int64 `json:"intermediateCommits"`
+	ReadOnly            int64 `json:"readOnly,omitempty"`
+	DirtyReadOnly       int64 `json:"dirtyReadOnly,omitempty"`
+}
+
+// MemoryStats contains statistics about memory usage.
+type MemoryStats struct {
+	ContextID    int64   `json:"contextId"`
+	TMax         float64 `json:"tMax"`
+	CountOfTimes int64   `json:"countOfTimes"`
+	HeapMax      int64   `json:"heapMax"`
+	HeapMin      int64   `json:"heapMin"`
+	Invocations  int64   `json:"invocations,omitempty"`
+}
+
+// V8ContextStats contains statistics about V8 contexts.
+type V8ContextStats struct {
+	Available int64         `json:"available"`
+	Busy      int64         `json:"busy"`
+	Dirty     int64         `json:"dirty"`
+	Free      int64         `json:"free"`
+	Min       int64         `json:"min,omitempty"`
+	Max       int64         `json:"max"`
+	Memory    []MemoryStats `json:"memory"`
+}
+
+// ThreadStats contains statistics about threads.
+type ThreadStats struct {
+	SchedulerThreads int64 `json:"scheduler-threads"`
+	Blocked          int64 `json:"blocked"`
+	Queued           int64 `json:"queued"`
+	InProgress       int64 `json:"in-progress"`
+	DirectExec       int64 `json:"direct-exec"`
+}
+
+// ServerStats contains statistics about the server.
+type ServerStats struct {
+	Uptime         float64          `json:"uptime"`
+	PhysicalMemory int64            `json:"physicalMemory"`
+	Transactions   TransactionStats `json:"transactions"`
+	V8Context      V8ContextStats   `json:"v8Context"`
+	Threads        ThreadStats      `json:"threads"`
+}
+
+func (c *clientAdmin) Metrics(ctx context.Context) (Metrics, error) {
+	return c.getMetrics(ctx, "")
+}
+
+func (c *clientAdmin) MetricsForSingleServer(ctx context.Context, serverID ServerID) (Metrics, error) {
+	return c.getMetrics(ctx, serverID)
+}
+
+func (c *clientAdmin) getMetrics(ctx context.Context, serverID ServerID) (Metrics, error) {
+	url := connection.NewUrl("_admin", "metrics", "v2")
+
+	var mods []connection.RequestModifier
+	if serverID != "" {
+		mods = append(mods, connection.WithQuery("serverId", string(serverID)))
+	}
+
+	var response []byte
+
+	_, err := connection.CallWithChecks(ctx, c.client.connection, http.MethodGet, url, &response, []int{http.StatusOK}, mods...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ParseMetrics(bytes.NewReader(response))
+}
+
+func (c *clientAdmin) Statistics(ctx context.Context) (ServerStatistics, error) {
+	url := connection.NewUrl("_admin", "statistics")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ServerStatistics      `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return ServerStatistics{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ServerStatistics, nil
+	default:
+		return ServerStatistics{}, response.AsArangoErrorWithCode(code)
+	}
+}
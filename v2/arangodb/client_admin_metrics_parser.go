@@ -0,0 +1,403 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MetricType is the type of metric family.
+type MetricType string
+
+const (
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeHistogram MetricType = "histogram"
+	MetricTypeSummary   MetricType = "summary"
+	MetricTypeUntyped   MetricType = "untyped"
+)
+
+// Metrics contains the metric families by their names, e.g. "arangodb_client_connection_statistics".
+type Metrics map[string]MetricFamily
+
+// MetricFamily is a group of metrics with the same name and different labels.
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Metrics []Metric
+}
+
+// Metric is a single metric of the family.
+type Metric struct {
+	// Labels of the metric. For the histogram the "le" label and for the summary the "quantile" label are not included.
+	Labels map[string]string
+
+	// Value of the counter, gauge or untyped metric.
+	Value float64
+
+	// Histogram is set for the metric of MetricTypeHistogram type.
+	Histogram *MetricHistogram
+
+	// Summary is set for the metric of MetricTypeSummary type.
+	Summary *MetricSummary
+}
+
+// MetricHistogram contains the buckets of the histogram metric.
+type MetricHistogram struct {
+	// Buckets are cumulative and ordered by their upper bound, as reported by the server.
+	Buckets []MetricBucket
+	Sum     float64
+	Count   float64
+}
+
+// MetricBucket is a single bucket of the histogram metric.
+type MetricBucket struct {
+	// UpperBound is the inclusive upper bound of the bucket (the "le" label). The last bucket has +Inf upper bound.
+	UpperBound float64
+	// Count is the number of observations less or equal to the upper bound.
+	Count float64
+}
+
+// MetricSummary contains the quantiles of the summary metric.
+type MetricSummary struct {
+	Quantiles []MetricQuantile
+	Sum       float64
+	Count     float64
+}
+
+// MetricQuantile is a single quantile of the summary metric.
+type MetricQuantile struct {
+	Quantile float64
+	Value    float64
+}
+
+// Find returns the metric of the family with the given labels. Return false if not found.
+func (m MetricFamily) Find(labels map[string]string) (Metric, bool) {
+	for _, metric := range m.Metrics {
+		if len(metric.Labels) != len(labels) {
+			continue
+		}
+
+		matches := true
+		for k, v := range labels {
+			if value, ok := metric.Labels[k]; !ok || value != v {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return metric, true
+		}
+	}
+
+	return Metric{}, false
+}
+
+// ParseMetrics parses metrics in the Prometheus text format.
+func ParseMetrics(r io.Reader) (Metrics, error) {
+	p := metricsParser{
+		metrics: Metrics{},
+		index:   map[string]map[string]int{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if err := p.parseLine(strings.TrimSpace(scanner.Text())); err != nil {
+			return nil, errors.Wrapf(err, "invalid metrics line %d", lineNumber)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return p.metrics, nil
+}
+
+type metricsParser struct {
+	metrics Metrics
+
+	// index maps the family name and the labels of the metric to its position in the family.
+	index map[string]map[string]int
+}
+
+func (p *metricsParser) parseLine(line string) error {
+	if line == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(line, "#") {
+		p.parseComment(line)
+		return nil
+	}
+
+	name, labels, value, err := parseMetricSample(line)
+	if err != nil {
+		return err
+	}
+
+	family, suffix := p.family(name)
+
+	var special string
+	switch family.Type {
+	case MetricTypeHistogram:
+		special = "le"
+	case MetricTypeSummary:
+		special = "quantile"
+	}
+
+	specialValue, hasSpecial := labels[special]
+	if hasSpecial {
+		delete(labels, special)
+	}
+
+	metric := p.metric(&family, labels)
+
+	switch family.Type {
+	case MetricTypeHistogram:
+		if metric.Histogram == nil {
+			metric.Histogram = &MetricHistogram{}
+		}
+
+		switch {
+		case suffix == "_bucket" && hasSpecial:
+			bound, err := parseMetricValue(specialValue)
+			if err != nil {
+				return err
+			}
+			metric.Histogram.Buckets = append(metric.Histogram.Buckets, MetricBucket{UpperBound: bound, Count: value})
+		case suffix == "_sum":
+			metric.Histogram.Sum = value
+		case suffix == "_count":
+			metric.Histogram.Count = value
+		}
+	case MetricTypeSummary:
+		if metric.Summary == nil {
+			metric.Summary = &MetricSummary{}
+		}
+
+		switch {
+		case suffix == "" && hasSpecial:
+			quantile, err := parseMetricValue(specialValue)
+			if err != nil {
+				return err
+			}
+			metric.Summary.Quantiles = append(metric.Summary.Quantiles, MetricQuantile{Quantile: quantile, Value: value})
+		case suffix == "_sum":
+			metric.Summary.Sum = value
+		case suffix == "_count":
+			metric.Summary.Count = value
+		}
+	default:
+		metric.Value = value
+	}
+
+	p.metrics[family.Name] = family
+	return nil
+}
+
+// parseComment handles the "# HELP <name> <text>" and "# TYPE <name> <type>" lines. Other comments are ignored.
+func (p *metricsParser) parseComment(line string) {
+	fields := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(line, "#")), " ", 3)
+	if len(fields) < 3 {
+		return
+	}
+
+	family, ok := p.metrics[fields[1]]
+	if !ok {
+		family = MetricFamily{Name: fields[1], Type: MetricTypeUntyped}
+	}
+
+	switch fields[0] {
+	case "HELP":
+		family.Help = unescapeMetricText(fields[2], false)
+	case "TYPE":
+		family.Type = MetricType(strings.TrimSpace(fields[2]))
+	default:
+		return
+	}
+
+	p.metrics[family.Name] = family
+}
+
+// family returns the family of the sample and the suffix of the sample name, e.g. "_bucket" for the histogram.
+func (p *metricsParser) family(name string) (MetricFamily, string) {
+	if family, ok := p.metrics[name]; ok {
+		return family, ""
+	}
+
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+
+		family, ok := p.metrics[strings.TrimSuffix(name, suffix)]
+		if !ok {
+			continue
+		}
+
+		if family.Type == MetricTypeHistogram || (family.Type == MetricTypeSummary && suffix != "_bucket") {
+			return family, suffix
+		}
+	}
+
+	return MetricFamily{Name: name, Type: MetricTypeUntyped}, ""
+}
+
+// metric returns the metric of the family with the given labels. The metric is added to the family if it does not exist.
+func (p *metricsParser) metric(family *MetricFamily, labels map[string]string) *Metric {
+	key := metricLabelsKey(labels)
+
+	positions, ok := p.index[family.Name]
+	if !ok {
+		positions = map[string]int{}
+		p.index[family.Name] = positions
+	}
+
+	if position, ok := positions[key]; ok {
+		return &family.Metrics[position]
+	}
+
+	positions[key] = len(family.Metrics)
+	family.Metrics = append(family.Metrics, Metric{Labels: labels})
+
+	return &family.Metrics[len(family.Metrics)-1]
+}
+
+func metricLabelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(labels[k]))
+		b.WriteByte(',')
+	}
+
+	return b.String()
+}
+
+// parseMetricSample parses the `name{label="value",...} value [timestamp]` line.
+func parseMetricSample(line string) (string, map[string]string, float64, error) {
+	labels := map[string]string{}
+
+	end := strings.IndexAny(line, "{ \t")
+	if end <= 0 {
+		return "", nil, 0, errors.Errorf("missing value in %q", line)
+	}
+
+	name := line[:end]
+	rest := line[end:]
+
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		if rest, err = parseMetricLabels(rest[1:], labels); err != nil {
+			return "", nil, 0, err
+		}
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", nil, 0, errors.Errorf("missing value in %q", line)
+	}
+
+	value, err := parseMetricValue(fields[0])
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	return name, labels, value, nil
+}
+
+// parseMetricLabels parses the labels until the closing bracket and returns the rest of the line.
+func parseMetricLabels(s string, labels map[string]string) (string, error) {
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		if strings.HasPrefix(s, "}") {
+			return s[1:], nil
+		}
+
+		eq := strings.IndexByte(s, '=')
+		if eq <= 0 || len(s) < eq+2 || s[eq+1] != '"' {
+			return "", errors.Errorf("invalid label in %q", s)
+		}
+
+		name := strings.TrimSpace(s[:eq])
+		s = s[eq+2:]
+
+		// Find the closing quote, which is not escaped.
+		end := -1
+		for i := 0; i < len(s); i++ {
+			if s[i] == '\\' {
+				i++
+				continue
+			}
+			if s[i] == '"' {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return "", errors.Errorf("unterminated value of label %q", name)
+		}
+
+		labels[name] = unescapeMetricText(s[:end], true)
+		s = s[end+1:]
+	}
+}
+
+func parseMetricValue(s string) (float64, error) {
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return value, nil
+}
+
+// unescapeMetricText replaces the escape sequences of the help text or of the label value.
+func unescapeMetricText(s string, quoted bool) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+
+	replacements := []string{`\\`, `\`, `\n`, "\n"}
+	if quoted {
+		replacements = append(replacements, `\"`, `"`)
+	}
+
+	return strings.NewReplacer(replacements...).Replace(s)
+}
@@ -0,0 +1,112 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMetrics = `# HELP arangodb_client_connection_statistics Histogram of client connection durations
+# TYPE arangodb_client_connection_statistics histogram
+arangodb_client_connection_statistics_bucket{role="SINGLE",le="0.01"} 3
+arangodb_client_connection_statistics_bucket{role="SINGLE",le="1.0"} 5
+arangodb_client_connection_statistics_bucket{role="SINGLE",le="+Inf"} 6
+arangodb_client_connection_statistics_sum{role="SINGLE"} 12.5
+arangodb_client_connection_statistics_count{role="SINGLE"} 6
+# HELP arangodb_process_statistics_resident_set_size Resident set size
+# TYPE arangodb_process_statistics_resident_set_size gauge
+arangodb_process_statistics_resident_set_size{role="SINGLE"} 1.23e+08
+# TYPE arangodb_http_request_statistics_total_requests_total counter
+arangodb_http_request_statistics_total_requests_total{role="SINGLE",shortname="a \"b\""} 42 1700000000000
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} 0.2
+rpc_duration_seconds{quantile="0.9"} 0.8
+rpc_duration_seconds_sum 10
+rpc_duration_seconds_count 20
+no_metadata_metric 7
+`
+
+func TestParseMetrics(t *testing.T) {
+	metrics, err := ParseMetrics(strings.NewReader(testMetrics))
+	require.NoError(t, err)
+	require.Len(t, metrics, 5)
+
+	t.Run("Histogram", func(t *testing.T) {
+		family := metrics["arangodb_client_connection_statistics"]
+		assert.Equal(t, MetricTypeHistogram, family.Type)
+		assert.Equal(t, "Histogram of client connection durations", family.Help)
+		require.Len(t, family.Metrics, 1)
+
+		metric, ok := family.Find(map[string]string{"role": "SINGLE"})
+		require.True(t, ok)
+		require.NotNil(t, metric.Histogram)
+		assert.Equal(t, []MetricBucket{{0.01, 3}, {1, 5}, {math.Inf(1), 6}}, metric.Histogram.Buckets)
+		assert.Equal(t, 12.5, metric.Histogram.Sum)
+		assert.Equal(t, 6.0, metric.Histogram.Count)
+	})
+
+	t.Run("Gauge and counter", func(t *testing.T) {
+		gauge := metrics["arangodb_process_statistics_resident_set_size"]
+		assert.Equal(t, MetricTypeGauge, gauge.Type)
+		require.Len(t, gauge.Metrics, 1)
+		assert.Equal(t, 1.23e+08, gauge.Metrics[0].Value)
+
+		counter := metrics["arangodb_http_request_statistics_total_requests_total"]
+		assert.Equal(t, MetricTypeCounter, counter.Type)
+		metric, ok := counter.Find(map[string]string{"role": "SINGLE", "shortname": `a "b"`})
+		require.True(t, ok)
+		assert.Equal(t, 42.0, metric.Value)
+
+		_, ok = counter.Find(map[string]string{"role": "SINGLE"})
+		assert.False(t, ok)
+	})
+
+	t.Run("Summary", func(t *testing.T) {
+		family := metrics["rpc_duration_seconds"]
+		require.Len(t, family.Metrics, 1)
+		require.NotNil(t, family.Metrics[0].Summary)
+		assert.Equal(t, []MetricQuantile{{0.5, 0.2}, {0.9, 0.8}}, family.Metrics[0].Summary.Quantiles)
+		assert.Equal(t, 20.0, family.Metrics[0].Summary.Count)
+	})
+
+	t.Run("Untyped", func(t *testing.T) {
+		family := metrics["no_metadata_metric"]
+		assert.Equal(t, MetricTypeUntyped, family.Type)
+		assert.Equal(t, 7.0, family.Metrics[0].Value)
+	})
+}
+
+func TestParseMetricsInvalid(t *testing.T) {
+	for _, input := range []string{
+		"metric_without_value",
+		`metric{label="value} 1`,
+		`metric{label=value} 1`,
+		"metric abc",
+	} {
+		_, err := ParseMetrics(strings.NewReader(input))
+		assert.Error(t, err, input)
+	}
+}
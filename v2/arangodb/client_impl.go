@@ -22,12 +22,20 @@ package arangodb
 
 import (
 	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/log"
 )
 
 func NewClient(connection connection.Connection) Client {
 	return newClient(connection)
 }
 
+// NewClientWithLogger creates the client whose requests are logged with the given logger,
+// instead of the logger of the connection configuration or the global logger.
+// It allows to distinguish the logs of several clients, e.g. with the logger created with log.With.
+func NewClientWithLogger(conn connection.Connection, logger log.Log) Client {
+	return newClient(connection.NewContextLoggerWrapper(logger)(conn))
+}
+
 func newClient(connection connection.Connection) *client {
 	c := &client{
 		connection: connection,
@@ -38,6 +46,8 @@ func newClient(connection connection.Connection) *client {
 	c.clientServerInfo = newClientServerInfo(c)
 	c.clientAdmin = newClientAdmin(c)
 	c.clientAsyncJob = newClientAsyncJob(c)
+	c.clientReplication = newClientReplication(c)
+	c.clientTasks = newClientTasks(c)
 
 	c.Requests = NewRequests(connection)
 
@@ -54,6 +64,8 @@ type client struct {
 	*clientServerInfo
 	*clientAdmin
 	*clientAsyncJob
+	*clientReplication
+	*clientTasks
 
 	Requests
 }
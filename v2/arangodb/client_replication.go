@@ -0,0 +1,373 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// ClientReplication provides access to the replication API.
+// https://docs.arangodb.com/stable/develop/http-api/replication/
+type ClientReplication interface {
+	// CreateBatch creates a "batch" to prevent removal of state required for replication.
+	CreateBatch(ctx context.Context, dbName string, serverID int64, ttl time.Duration) (ReplicationBatch, error)
+
+	// GetReplicationInventory returns the inventory of the server containing all collections (with entire details) of a database.
+	// When this function is called on a coordinator in a cluster, an ID of a DBServer must be provided in the options.
+	GetReplicationInventory(ctx context.Context, dbName string, opts *ReplicationInventoryOptions) (ReplicationInventory, error)
+
+	// GetRevisionTree retrieves the Revision tree (Merkle tree) associated with the collection.
+	GetRevisionTree(ctx context.Context, dbName, batchID, collection string) (RevisionTree, error)
+
+	// GetRevisionsByRanges retrieves the revision IDs of documents within requested ranges.
+	GetRevisionsByRanges(ctx context.Context, dbName, batchID, collection string, minMaxRevision []RevisionMinMax,
+		resume RevisionUInt64) (RevisionRanges, error)
+
+	// GetRevisionDocuments retrieves documents by revision.
+	GetRevisionDocuments(ctx context.Context, dbName, batchID, collection string,
+		revisions Revisions) ([]map[string]interface{}, error)
+
+	// GetReplicationLoggerState returns the current state of the server's replication logger.
+	GetReplicationLoggerState(ctx context.Context, dbName string) (ReplicationLoggerState, error)
+
+	// GetReplicationLoggerFirstTick returns the first available tick value that can be served from the server's replication log.
+	GetReplicationLoggerFirstTick(ctx context.Context, dbName string) (Tick, error)
+
+	// TailWAL returns the operations from the server's write-ahead log (WAL).
+	// The markers are read from the response body one by one, so the response is never loaded into memory as a whole.
+	// The caller is responsible for closing the returned reader.
+	TailWAL(ctx context.Context, dbName string, opts *WALTailOptions) (WALTailReader, error)
+
+	// GetWALRange returns the currently available ranges of tick values for all WAL files.
+	GetWALRange(ctx context.Context, dbName string) (WALRange, error)
+
+	// GetReplicationApplierConfig returns the configuration of the replication applier.
+	GetReplicationApplierConfig(ctx context.Context, dbName string, opts *ReplicationApplierOptions) (ReplicationApplierConfig, error)
+
+	// SetReplicationApplierConfig sets the configuration of the replication applier.
+	// The configuration can only be changed while the applier is not running.
+	SetReplicationApplierConfig(ctx context.Context, dbName string, config ReplicationApplierConfig,
+		opts *ReplicationApplierOptions) (ReplicationApplierConfig, error)
+
+	// StartReplicationApplier starts the replication applier.
+	StartReplicationApplier(ctx context.Context, dbName string, opts *ReplicationApplierStartOptions) (ReplicationApplierState, error)
+
+	// StopReplicationApplier stops the replication applier.
+	StopReplicationApplier(ctx context.Context, dbName string, opts *ReplicationApplierOptions) (ReplicationApplierState, error)
+
+	// GetReplicationApplierState returns the state of the replication applier.
+	GetReplicationApplierState(ctx context.Context, dbName string, opts *ReplicationApplierOptions) (ReplicationApplierState, error)
+}
+
+// Tick represents a place in either the Write-Ahead Log,
+// journals and datafiles value reported by the server.
+type Tick string
+
+// ReplicationBatch represents state on the server used during certain replication operations to keep state required
+// by the client (such as Write-Ahead Log, inventory and data-files).
+type ReplicationBatch interface {
+	// BatchID returns the ID of this batch.
+	BatchID() string
+
+	// LastTick returns the last tick reported by the server for this batch.
+	LastTick() Tick
+
+	// Extend extends the lifetime of an existing batch on the server.
+	Extend(ctx context.Context, ttl time.Duration) error
+
+	// Delete deletes an existing batch on the server.
+	Delete(ctx context.Context) error
+}
+
+// ErrReplicationBatchClosed occurs when there is an attempt to extend or to delete a batch which has been deleted.
+var ErrReplicationBatchClosed = errors.New("batch already closed")
+
+type ReplicationInventoryOptions struct {
+	// BatchID of a batch which was created with CreateBatch. It is required since ArangoDB 3.8.
+	BatchID string
+
+	// IncludeSystem includes system collections in the result.
+	IncludeSystem *bool
+
+	// Global returns the inventory of all databases. It is only allowed on the _system database.
+	Global *bool
+
+	// Collection restricts the result to the collection with the given name.
+	Collection string
+
+	// DBServerID is the ID of the DBServer which inventory should be returned.
+	// It is required when the request is sent to a coordinator.
+	DBServerID ServerID
+}
+
+func (r *ReplicationInventoryOptions) modifyRequest(req connection.Request) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.BatchID != "" {
+		req.AddQuery("batchId", r.BatchID)
+	}
+
+	if r.IncludeSystem != nil {
+		req.AddQuery("includeSystem", boolToString(*r.IncludeSystem))
+	}
+
+	if r.Global != nil {
+		req.AddQuery("global", boolToString(*r.Global))
+	}
+
+	if r.Collection != "" {
+		req.AddQuery("collection", r.Collection)
+	}
+
+	if r.DBServerID != "" {
+		req.AddQuery("DBserver", string(r.DBServerID))
+	}
+
+	return nil
+}
+
+// ReplicationInventory contains all collections and views of a database (or of all databases) of a server.
+type ReplicationInventory struct {
+	// Info contains details of the database. It is not present for the global inventory.
+	Info DatabaseInfo `json:"properties,omitempty"`
+	// Collections contains details of all collections. It is not present for the global inventory.
+	Collections []InventoryCollection `json:"collections,omitempty"`
+	// Views contains details of all views. It is not present for the global inventory.
+	Views []InventoryView `json:"views,omitempty"`
+	// Databases contains the inventories of all databases in case of the global inventory.
+	Databases map[string]ReplicationDatabaseInventory `json:"databases,omitempty"`
+	// State of the replication logger.
+	State ReplicationLoggerStatus `json:"state"`
+	// Tick is the last tick of the server at the time the inventory was created.
+	Tick Tick `json:"tick,omitempty"`
+}
+
+// ReplicationDatabaseInventory contains all collections and views of a single database in the global inventory.
+type ReplicationDatabaseInventory struct {
+	ID          string                `json:"id,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	Collections []InventoryCollection `json:"collections,omitempty"`
+	Views       []InventoryView       `json:"views,omitempty"`
+}
+
+// CollectionByName returns the InventoryCollection with given name. Return false if not found.
+func (i ReplicationInventory) CollectionByName(name string) (InventoryCollection, bool) {
+	for _, c := range i.Collections {
+		if c.Parameters.Name == name {
+			return c, true
+		}
+	}
+	return InventoryCollection{}, false
+}
+
+// ReplicationLoggerState contains the state of the server's replication logger.
+type ReplicationLoggerState struct {
+	// State of the replication logger.
+	State ReplicationLoggerStatus `json:"state"`
+	// Server contains details about the server.
+	Server ReplicationServerInfo `json:"server"`
+	// Clients contains the replication clients that have connected to the logger recently.
+	Clients []ReplicationLoggerClient `json:"clients,omitempty"`
+}
+
+// ReplicationLoggerStatus describes the state of the replication logger.
+type ReplicationLoggerStatus struct {
+	// Running is always true for the replication logger.
+	Running bool `json:"running"`
+	// LastLogTick is the tick value of the latest tick the logger has logged.
+	LastLogTick Tick `json:"lastLogTick,omitempty"`
+	// LastUncommittedLogTick is the tick value of the latest tick the logger has written (it may not be committed yet).
+	LastUncommittedLogTick Tick `json:"lastUncommittedLogTick,omitempty"`
+	// TotalEvents is the total number of events logged since the server was started.
+	TotalEvents int64 `json:"totalEvents,omitempty"`
+	// Time is the current time on the server.
+	Time time.Time `json:"time,omitempty"`
+}
+
+// ReplicationServerInfo describes the server which sent the replication response.
+type ReplicationServerInfo struct {
+	Version  string `json:"version,omitempty"`
+	ServerID string `json:"serverId,omitempty"`
+	Engine   string `json:"engine,omitempty"`
+}
+
+// ReplicationLoggerClient describes a replication client of the logger.
+type ReplicationLoggerClient struct {
+	SyncerID       string    `json:"syncerId,omitempty"`
+	ServerID       string    `json:"serverId,omitempty"`
+	ClientInfo     string    `json:"clientInfo,omitempty"`
+	Time           time.Time `json:"time,omitempty"`
+	Expires        time.Time `json:"expires,omitempty"`
+	LastServedTick Tick      `json:"lastServedTick,omitempty"`
+}
+
+// WALRange contains the currently available ranges of tick values for all WAL files.
+type WALRange struct {
+	// Time is the current time on the server.
+	Time time.Time `json:"time,omitempty"`
+	// TickMin is the minimum tick available.
+	TickMin Tick `json:"tickMin,omitempty"`
+	// TickMax is the maximum tick available.
+	TickMax Tick `json:"tickMax,omitempty"`
+	// Server contains details about the server.
+	Server ReplicationServerInfo `json:"server"`
+}
+
+type ReplicationApplierOptions struct {
+	// Global if set to true, the server-global replication applier is used instead of the one of the database.
+	Global *bool
+}
+
+func (r *ReplicationApplierOptions) modifyRequest(req connection.Request) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.Global != nil {
+		req.AddQuery("global", boolToString(*r.Global))
+	}
+
+	return nil
+}
+
+type ReplicationApplierStartOptions struct {
+	ReplicationApplierOptions
+
+	// From is the remote lastLogTick value from which to start applying.
+	// If not specified, the last saved tick from the previous applier run is used.
+	From Tick
+}
+
+func (r *ReplicationApplierStartOptions) modifyRequest(req connection.Request) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.From != "" {
+		req.AddQuery("from", string(r.From))
+	}
+
+	return r.ReplicationApplierOptions.modifyRequest(req)
+}
+
+// ReplicationApplierConfig is the configuration of the replication applier.
+type ReplicationApplierConfig struct {
+	// Endpoint is the logger server to connect to (e.g. "tcp://192.168.173.13:8529").
+	Endpoint string `json:"endpoint,omitempty"`
+	// Database is the name of the database on the endpoint.
+	Database string `json:"database,omitempty"`
+	// Username is an optional ArangoDB username to use when connecting to the endpoint.
+	Username string `json:"username,omitempty"`
+	// Password to use when connecting to the endpoint. It is never returned by the server.
+	Password string `json:"password,omitempty"`
+	// MaxConnectRetries is the maximum number of connection attempts the applier makes in a row.
+	MaxConnectRetries *int `json:"maxConnectRetries,omitempty"`
+	// ConnectTimeout is the timeout (in seconds) when attempting to connect to the endpoint.
+	ConnectTimeout *float64 `json:"connectTimeout,omitempty"`
+	// RequestTimeout is the timeout (in seconds) for individual requests to the endpoint.
+	RequestTimeout *float64 `json:"requestTimeout,omitempty"`
+	// ChunkSize is the requested maximum size for log transfer packets that is used when the endpoint is contacted.
+	ChunkSize *int64 `json:"chunkSize,omitempty"`
+	// AutoStart starts the applier automatically the next time the server is restarted.
+	AutoStart *bool `json:"autoStart,omitempty"`
+	// AdaptivePolling makes the applier sleep for an increasingly long period in case the logger has no log events.
+	AdaptivePolling *bool `json:"adaptivePolling,omitempty"`
+	// IncludeSystem controls whether system collection operations are applied.
+	IncludeSystem *bool `json:"includeSystem,omitempty"`
+	// AutoResync enables a full automatic resynchronization with the master in case the master cannot serve log data.
+	AutoResync *bool `json:"autoResync,omitempty"`
+	// AutoResyncRetries is the number of resynchronization retries in case an automatic resync is enabled.
+	AutoResyncRetries *int `json:"autoResyncRetries,omitempty"`
+	// InitialSyncMaxWaitTime is the maximum wait time (in seconds) that the initial synchronization waits for a response.
+	InitialSyncMaxWaitTime *float64 `json:"initialSyncMaxWaitTime,omitempty"`
+	// ConnectionRetryWaitTime is the time (in seconds) that the applier idles before trying to connect again.
+	ConnectionRetryWaitTime *float64 `json:"connectionRetryWaitTime,omitempty"`
+	// IdleMinWaitTime is the minimum wait time (in seconds) that the applier idles before fetching more log data.
+	IdleMinWaitTime *float64 `json:"idleMinWaitTime,omitempty"`
+	// IdleMaxWaitTime is the maximum wait time (in seconds) that the applier idles before fetching more log data.
+	IdleMaxWaitTime *float64 `json:"idleMaxWaitTime,omitempty"`
+	// RequireFromPresent makes the applier check at start whether the start tick is still present on the master.
+	RequireFromPresent *bool `json:"requireFromPresent,omitempty"`
+	// Verbose logs all applied operations.
+	Verbose *bool `json:"verbose,omitempty"`
+	// RestrictType is either "include" or "exclude" and is used together with RestrictCollections.
+	RestrictType string `json:"restrictType,omitempty"`
+	// RestrictCollections is the list of collections to include or exclude.
+	RestrictCollections []string `json:"restrictCollections,omitempty"`
+}
+
+// ReplicationApplierState describes the replication applier.
+type ReplicationApplierState struct {
+	// State of the replication applier.
+	State ReplicationApplierStatus `json:"state"`
+	// Server contains details about the server.
+	Server ReplicationServerInfo `json:"server"`
+	// Endpoint is the endpoint the applier is connected to.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Database is the name of the database the applier is connected to.
+	Database string `json:"database,omitempty"`
+}
+
+// ReplicationApplierStatus describes the state of the replication applier.
+type ReplicationApplierStatus struct {
+	Running                     bool                       `json:"running"`
+	Phase                       string                     `json:"phase,omitempty"`
+	LastAppliedContinuousTick   Tick                       `json:"lastAppliedContinuousTick,omitempty"`
+	LastProcessedContinuousTick Tick                       `json:"lastProcessedContinuousTick,omitempty"`
+	LastAvailableContinuousTick Tick                       `json:"lastAvailableContinuousTick,omitempty"`
+	SafeResumeTick              Tick                       `json:"safeResumeTick,omitempty"`
+	Progress                    ReplicationApplierProgress `json:"progress"`
+	TotalRequests               int64                      `json:"totalRequests,omitempty"`
+	TotalFailedConnects         int64                      `json:"totalFailedConnects,omitempty"`
+	TotalEvents                 int64                      `json:"totalEvents,omitempty"`
+	TotalDocuments              int64                      `json:"totalDocuments,omitempty"`
+	TotalRemovals               int64                      `json:"totalRemovals,omitempty"`
+	TotalResyncs                int64                      `json:"totalResyncs,omitempty"`
+	TotalOperationsExcluded     int64                      `json:"totalOperationsExcluded,omitempty"`
+	TotalApplyTime              float64                    `json:"totalApplyTime,omitempty"`
+	AverageApplyTime            float64                    `json:"averageApplyTime,omitempty"`
+	TotalFetchTime              float64                    `json:"totalFetchTime,omitempty"`
+	AverageFetchTime            float64                    `json:"averageFetchTime,omitempty"`
+	LastError                   *ReplicationApplierError   `json:"lastError,omitempty"`
+	Time                        time.Time                  `json:"time,omitempty"`
+}
+
+// ReplicationApplierProgress describes the last action of the replication applier.
+type ReplicationApplierProgress struct {
+	// Time is empty if the applier has not run yet.
+	Time           string `json:"time,omitempty"`
+	Message        string `json:"message,omitempty"`
+	FailedConnects int64  `json:"failedConnects,omitempty"`
+}
+
+// ReplicationApplierError describes the last error of the replication applier.
+type ReplicationApplierError struct {
+	ErrorNum     int    `json:"errorNum,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	Time         string `json:"time,omitempty"`
+}
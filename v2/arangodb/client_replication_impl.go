@@ -0,0 +1,444 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+var _ ClientReplication = &clientReplication{}
+
+type clientReplication struct {
+	client *client
+}
+
+func newClientReplication(client *client) *clientReplication {
+	return &clientReplication{
+		client: client,
+	}
+}
+
+// url creates the path to the replication API of the database: `_db/<db-name>/_api/<parts>`.
+func (c *clientReplication) url(dbName string, parts ...string) string {
+	return connection.NewUrl(append([]string{"_db", url.PathEscape(dbName), "_api"}, parts...)...)
+}
+
+// withModifiers returns the request modifiers of the database followed by the given modifiers,
+// so the replication requests are modified in the same way as the other requests of the database.
+func (c *clientReplication) withModifiers(dbName string, modifiers ...connection.RequestModifier) []connection.RequestModifier {
+	return newDatabase(c.client, dbName).withModifiers(modifiers...)
+}
+
+func (c *clientReplication) CreateBatch(ctx context.Context, dbName string, serverID int64, ttl time.Duration) (ReplicationBatch, error) {
+	urlEndpoint := c.url(dbName, "replication", "batch")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ID                    string `json:"id"`
+		LastTick              Tick   `json:"lastTick,omitempty"`
+	}
+
+	body := struct {
+		TTL float64 `json:"ttl"`
+	}{
+		TTL: ttl.Seconds(),
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, urlEndpoint, &response, body,
+		c.withModifiers(dbName, connection.WithQuery("serverId", strconv.FormatInt(serverID, 10)))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return &replicationBatch{
+			id:          response.ID,
+			lastTick:    response.LastTick,
+			replication: c,
+			serverID:    serverID,
+			dbName:      dbName,
+		}, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetReplicationInventory(ctx context.Context, dbName string, opts *ReplicationInventoryOptions) (ReplicationInventory, error) {
+	urlEndpoint := c.url(dbName, "replication", "inventory")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ReplicationInventory  `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response, c.withModifiers(dbName, opts.modifyRequest)...)
+	if err != nil {
+		return ReplicationInventory{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ReplicationInventory, nil
+	default:
+		return ReplicationInventory{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetRevisionTree(ctx context.Context, dbName, batchID, collection string) (RevisionTree, error) {
+	urlEndpoint := c.url(dbName, "replication", "revisions", "tree")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		RevisionTree          `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response,
+		c.withModifiers(dbName, connection.WithQuery("batchId", batchID), connection.WithQuery("collection", collection))...)
+	if err != nil {
+		return RevisionTree{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.RevisionTree, nil
+	default:
+		return RevisionTree{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetRevisionsByRanges(ctx context.Context, dbName, batchID, collection string,
+	minMaxRevision []RevisionMinMax, resume RevisionUInt64) (RevisionRanges, error) {
+	urlEndpoint := c.url(dbName, "replication", "revisions", "ranges")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		RevisionRanges        `json:",inline"`
+	}
+
+	mods := []connection.RequestModifier{
+		connection.WithQuery("batchId", batchID),
+		connection.WithQuery("collection", collection),
+	}
+	if resume > 0 {
+		mods = append(mods, connection.WithQuery("resume", resume.String()))
+	}
+
+	resp, err := connection.CallPut(ctx, c.client.connection, urlEndpoint, &response, minMaxRevision, c.withModifiers(dbName, mods...)...)
+	if err != nil {
+		return RevisionRanges{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.RevisionRanges, nil
+	default:
+		return RevisionRanges{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetRevisionDocuments(ctx context.Context, dbName, batchID, collection string,
+	revisions Revisions) ([]map[string]interface{}, error) {
+	urlEndpoint := c.url(dbName, "replication", "revisions", "documents")
+
+	var documents []map[string]interface{}
+
+	_, err := connection.CallWithChecks(ctx, c.client.connection, http.MethodPut, urlEndpoint, &documents,
+		[]int{http.StatusOK}, c.withModifiers(dbName, connection.WithQuery("batchId", batchID),
+			connection.WithQuery("collection", collection), connection.WithBody(revisions))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return documents, nil
+}
+
+func (c *clientReplication) GetReplicationLoggerState(ctx context.Context, dbName string) (ReplicationLoggerState, error) {
+	urlEndpoint := c.url(dbName, "replication", "logger-state")
+
+	var response struct {
+		shared.ResponseStruct  `json:",inline"`
+		ReplicationLoggerState `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response, c.withModifiers(dbName)...)
+	if err != nil {
+		return ReplicationLoggerState{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ReplicationLoggerState, nil
+	default:
+		return ReplicationLoggerState{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetReplicationLoggerFirstTick(ctx context.Context, dbName string) (Tick, error) {
+	urlEndpoint := c.url(dbName, "replication", "logger-first-tick")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FirstTick             Tick `json:"firstTick"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response, c.withModifiers(dbName)...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FirstTick, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) TailWAL(ctx context.Context, dbName string, opts *WALTailOptions) (WALTailReader, error) {
+	urlEndpoint := c.url(dbName, "wal", "tail")
+
+	// The markers are always requested as JSON, so they can be decoded one by one.
+	resp, body, err := connection.CallStream(ctx, c.client.connection, http.MethodGet, urlEndpoint,
+		c.withModifiers(dbName, connection.WithHeader("Accept", connection.ApplicationJSON), opts.modifyRequest)...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusNoContent:
+		return newWALTailReader(resp, body), nil
+	default:
+		defer body.Close()
+
+		var response shared.ResponseStruct
+		_ = json.NewDecoder(body).Decode(&response)
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetWALRange(ctx context.Context, dbName string) (WALRange, error) {
+	urlEndpoint := c.url(dbName, "wal", "range")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		WALRange              `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response, c.withModifiers(dbName)...)
+	if err != nil {
+		return WALRange{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.WALRange, nil
+	default:
+		return WALRange{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) GetReplicationApplierConfig(ctx context.Context, dbName string,
+	opts *ReplicationApplierOptions) (ReplicationApplierConfig, error) {
+	urlEndpoint := c.url(dbName, "replication", "applier-config")
+
+	var response struct {
+		shared.ResponseStruct    `json:",inline"`
+		ReplicationApplierConfig `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, urlEndpoint, &response, c.withModifiers(dbName, opts.modifyRequest)...)
+	if err != nil {
+		return ReplicationApplierConfig{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ReplicationApplierConfig, nil
+	default:
+		return ReplicationApplierConfig{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) SetReplicationApplierConfig(ctx context.Context, dbName string, config ReplicationApplierConfig,
+	opts *ReplicationApplierOptions) (ReplicationApplierConfig, error) {
+	urlEndpoint := c.url(dbName, "replication", "applier-config")
+
+	var response struct {
+		shared.ResponseStruct    `json:",inline"`
+		ReplicationApplierConfig `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.client.connection, urlEndpoint, &response, config, c.withModifiers(dbName, opts.modifyRequest)...)
+	if err != nil {
+		return ReplicationApplierConfig{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ReplicationApplierConfig, nil
+	default:
+		return ReplicationApplierConfig{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientReplication) StartReplicationApplier(ctx context.Context, dbName string,
+	opts *ReplicationApplierStartOptions) (ReplicationApplierState, error) {
+	return c.callReplicationApplier(ctx, dbName, http.MethodPut, "applier-start", opts.modifyRequest)
+}
+
+func (c *clientReplication) StopReplicationApplier(ctx context.Context, dbName string,
+	opts *ReplicationApplierOptions) (ReplicationApplierState, error) {
+	return c.callReplicationApplier(ctx, dbName, http.MethodPut, "applier-stop", opts.modifyRequest)
+}
+
+func (c *clientReplication) GetReplicationApplierState(ctx context.Context, dbName string,
+	opts *ReplicationApplierOptions) (ReplicationApplierState, error) {
+	return c.callReplicationApplier(ctx, dbName, http.MethodGet, "applier-state", opts.modifyRequest)
+}
+
+func (c *clientReplication) callReplicationApplier(ctx context.Context, dbName, method, action string,
+	mods ...connection.RequestModifier) (ReplicationApplierState, error) {
+	urlEndpoint := c.url(dbName, "replication", action)
+
+	var response struct {
+		shared.ResponseStruct   `json:",inline"`
+		ReplicationApplierState `json:",inline"`
+	}
+
+	resp, err := connection.Call(ctx, c.client.connection, method, urlEndpoint, &response, c.withModifiers(dbName, mods...)...)
+	if err != nil {
+		return ReplicationApplierState{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ReplicationApplierState, nil
+	default:
+		return ReplicationApplierState{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+var _ ReplicationBatch = &replicationBatch{}
+
+type replicationBatch struct {
+	id       string
+	lastTick Tick
+
+	replication *clientReplication
+	serverID    int64
+	dbName      string
+	closed      int32
+}
+
+func (b *replicationBatch) BatchID() string {
+	return b.id
+}
+
+func (b *replicationBatch) LastTick() Tick {
+	return b.lastTick
+}
+
+func (b *replicationBatch) Extend(ctx context.Context, ttl time.Duration) error {
+	if atomic.LoadInt32(&b.closed) != 0 {
+		return errors.WithStack(ErrReplicationBatchClosed)
+	}
+
+	urlEndpoint := b.replication.url(b.dbName, "replication", "batch", url.PathEscape(b.id))
+
+	body := struct {
+		TTL float64 `json:"ttl"`
+	}{
+		TTL: ttl.Seconds(),
+	}
+
+	_, err := connection.CallWithChecks(ctx, b.replication.client.connection, http.MethodPut, urlEndpoint, nil,
+		[]int{http.StatusNoContent}, b.replication.withModifiers(b.dbName, connection.WithBody(body),
+			connection.WithQuery("serverId", strconv.FormatInt(b.serverID, 10)))...)
+	return errors.WithStack(err)
+}
+
+func (b *replicationBatch) Delete(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&b.closed, 0, 1) {
+		return errors.WithStack(ErrReplicationBatchClosed)
+	}
+
+	urlEndpoint := b.replication.url(b.dbName, "replication", "batch", url.PathEscape(b.id))
+
+	_, err := connection.CallWithChecks(ctx, b.replication.client.connection, http.MethodDelete, urlEndpoint, nil,
+		[]int{http.StatusNoContent}, b.replication.withModifiers(b.dbName)...)
+	return errors.WithStack(err)
+}
+
+var _ WALTailReader = &walTailReader{}
+
+type walTailReader struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+	info    WALTailInfo
+}
+
+func newWALTailReader(resp connection.Response, body io.ReadCloser) *walTailReader {
+	return &walTailReader{
+		body:    body,
+		decoder: json.NewDecoder(body),
+		info: WALTailInfo{
+			CheckMore:    resp.Header("X-Arango-Replication-Checkmore") == "true",
+			FromPresent:  resp.Header("X-Arango-Replication-Frompresent") == "true",
+			Active:       resp.Header("X-Arango-Replication-Active") == "true",
+			LastIncluded: Tick(resp.Header("X-Arango-Replication-Lastincluded")),
+			LastScanned:  Tick(resp.Header("X-Arango-Replication-Lastscanned")),
+			LastTick:     Tick(resp.Header("X-Arango-Replication-Lasttick")),
+		},
+	}
+}
+
+func (w *walTailReader) Read() (WALMarker, error) {
+	var marker WALMarker
+	if err := w.decoder.Decode(&marker); err != nil {
+		if err == io.EOF {
+			return WALMarker{}, shared.NoMoreDocumentsError{}
+		}
+		return WALMarker{}, errors.WithStack(err)
+	}
+
+	return marker, nil
+}
+
+func (w *walTailReader) Info() WALTailInfo {
+	return w.info
+}
+
+func (w *walTailReader) Close() error {
+	return w.body.Close()
+}
@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func TestRevisionUInt64JSON(t *testing.T) {
+	ranges := RevisionRanges{
+		Ranges: []Revisions{{1, 64, 1 << 40}},
+		Resume: 1661,
+	}
+
+	data, err := json.Marshal(ranges)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ranges":[["_","_-","O------"]],"resume":"X7"}`, string(data))
+
+	var decoded RevisionRanges
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, ranges, decoded)
+
+	var empty RevisionUInt64
+	require.NoError(t, json.Unmarshal([]byte(`""`), &empty))
+	assert.Zero(t, empty)
+}
+
+type walTestResponse struct {
+	header http.Header
+}
+
+func (w walTestResponse) Code() int                   { return http.StatusOK }
+func (w walTestResponse) Response() interface{}       { return nil }
+func (w walTestResponse) Endpoint() string            { return "" }
+func (w walTestResponse) Content() string             { return "application/json" }
+func (w walTestResponse) Header(name string) string   { return w.header.Get(name) }
+func (w walTestResponse) RawResponse() *http.Response { return nil }
+
+func TestWALTailReader(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-arango-replication-checkmore", "true")
+	header.Set("x-arango-replication-frompresent", "true")
+	header.Set("x-arango-replication-lastincluded", "102")
+	header.Set("x-arango-replication-lastscanned", "103")
+
+	body := `{"tick":"101","type":2300,"db":"db","cuid":"h1","tid":"0","data":{"_key":"a","value":1}}
+{"tick":"102","type":2302,"db":"db","cuid":"h1","tid":"0","data":{"_key":"a","_rev":"_b"}}
+`
+	reader := newWALTailReader(walTestResponse{header: header}, io.NopCloser(strings.NewReader(body)))
+
+	assert.Equal(t, WALTailInfo{
+		CheckMore:    true,
+		FromPresent:  true,
+		LastIncluded: "102",
+		LastScanned:  "103",
+	}, reader.Info())
+
+	marker, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, Tick("101"), marker.Tick)
+	assert.Equal(t, WALMarkerTypeDocument, marker.Type)
+
+	var doc struct {
+		Key   string `json:"_key"`
+		Value int    `json:"value"`
+	}
+	require.NoError(t, marker.DecodeData(&doc))
+	assert.Equal(t, "a", doc.Key)
+	assert.Equal(t, 1, doc.Value)
+
+	marker, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, WALMarkerTypeDocumentRemove, marker.Type)
+
+	_, err = reader.Read()
+	assert.True(t, shared.IsNoMoreDocuments(err))
+	require.NoError(t, reader.Close())
+}
+
+func TestReplicationBatchTTL(t *testing.T) {
+	var requests testRequestLog
+	var bodies []string
+	db := newTestDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, strings.TrimSpace(requests.add(r)+" "+string(body)))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"id":"b1","lastTick":"10"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	ctx := context.Background()
+
+	batch, err := db.client.CreateBatch(ctx, "db", 1, 1500*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, batch.Extend(ctx, 1500*time.Millisecond))
+	require.NoError(t, batch.Delete(ctx))
+
+	// The TTL is sent as the same number of seconds when the batch is created and extended.
+	assert.Equal(t, []string{
+		`POST /_db/db/_api/replication/batch {"ttl":1.5}`,
+		`PUT /_db/db/_api/replication/batch/b1 {"ttl":1.5}`,
+		`DELETE /_db/db/_api/replication/batch/b1`,
+	}, bodies)
+}
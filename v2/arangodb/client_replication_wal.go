@@ -0,0 +1,182 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+type WALTailOptions struct {
+	// From is the exclusive lower bound tick value for results.
+	// The value of WALTailInfo.LastIncluded of the previous call should be used here to continue the tailing.
+	From Tick
+
+	// To is the inclusive upper bound tick value for results.
+	To Tick
+
+	// LastScanned should be set to the value of WALTailInfo.LastScanned of the previous call.
+	// It allows the server to continue the scan of a long-running transaction.
+	LastScanned Tick
+
+	// ChunkSize is the approximate maximum size of the returned result.
+	ChunkSize int64
+
+	// SyncerID is the ID of the client used to tail results.
+	// The server uses it to keep the operations after the client's last fetched tick.
+	SyncerID string
+
+	// ServerID is the ID of the client machine. If SyncerID is not set, the server uses it instead.
+	ServerID string
+
+	// ClientInfo is a short description of the client, used for informative purposes only.
+	ClientInfo string
+
+	// Global if set to true, the operations of all databases are returned.
+	// It is only allowed on the _system database.
+	Global *bool
+}
+
+func (w *WALTailOptions) modifyRequest(r connection.Request) error {
+	if w == nil {
+		return nil
+	}
+
+	if w.From != "" {
+		r.AddQuery("from", string(w.From))
+	}
+
+	if w.To != "" {
+		r.AddQuery("to", string(w.To))
+	}
+
+	if w.LastScanned != "" {
+		r.AddQuery("lastScanned", string(w.LastScanned))
+	}
+
+	if w.ChunkSize > 0 {
+		r.AddQuery("chunkSize", strconv.FormatInt(w.ChunkSize, 10))
+	}
+
+	if w.SyncerID != "" {
+		r.AddQuery("syncerId", w.SyncerID)
+	}
+
+	if w.ServerID != "" {
+		r.AddQuery("serverId", w.ServerID)
+	}
+
+	if w.ClientInfo != "" {
+		r.AddQuery("clientInfo", w.ClientInfo)
+	}
+
+	if w.Global != nil {
+		r.AddQuery("global", boolToString(*w.Global))
+	}
+
+	return nil
+}
+
+// WALTailReader reads the markers of the write-ahead log returned by the server.
+type WALTailReader interface {
+	io.Closer
+
+	// Read returns the next marker.
+	// It returns shared.NoMoreDocumentsError when there are no more markers in the response.
+	Read() (WALMarker, error)
+
+	// Info returns the tailing details sent by the server in the response headers.
+	Info() WALTailInfo
+}
+
+// WALTailInfo contains the tailing details which are needed to continue the tailing with the next call.
+type WALTailInfo struct {
+	// CheckMore is true if there are more markers available after the last one in the response.
+	CheckMore bool
+
+	// FromPresent is true if the requested From tick is still present in the write-ahead log.
+	// If it is false, some operations have been lost for the client and a resynchronization is needed.
+	FromPresent bool
+
+	// Active is true if the replication logger is active.
+	Active bool
+
+	// LastIncluded is the tick of the last marker included in the response. It is "0" if the response is empty.
+	LastIncluded Tick
+
+	// LastScanned is the last tick the server scanned while computing the response.
+	LastScanned Tick
+
+	// LastTick is the last tick value the server has logged.
+	LastTick Tick
+}
+
+// WALMarkerType is the type of the operation described by a WALMarker.
+type WALMarkerType int
+
+const (
+	WALMarkerTypeDatabaseCreate     WALMarkerType = 1100
+	WALMarkerTypeDatabaseDrop       WALMarkerType = 1101
+	WALMarkerTypeCollectionCreate   WALMarkerType = 2000
+	WALMarkerTypeCollectionDrop     WALMarkerType = 2001
+	WALMarkerTypeCollectionRename   WALMarkerType = 2002
+	WALMarkerTypeCollectionChange   WALMarkerType = 2003
+	WALMarkerTypeCollectionTruncate WALMarkerType = 2004
+	WALMarkerTypeIndexCreate        WALMarkerType = 2100
+	WALMarkerTypeIndexDrop          WALMarkerType = 2101
+	WALMarkerTypeViewCreate         WALMarkerType = 2110
+	WALMarkerTypeViewDrop           WALMarkerType = 2111
+	WALMarkerTypeViewChange         WALMarkerType = 2112
+	WALMarkerTypeTransactionStart   WALMarkerType = 2200
+	WALMarkerTypeTransactionCommit  WALMarkerType = 2201
+	WALMarkerTypeTransactionAbort   WALMarkerType = 2202
+	WALMarkerTypeDocument           WALMarkerType = 2300
+	WALMarkerTypeDocumentRemove     WALMarkerType = 2302
+)
+
+// WALMarker is a single operation from the write-ahead log.
+type WALMarker struct {
+	// Tick is the tick of the operation.
+	Tick Tick `json:"tick"`
+
+	// Type of the operation.
+	Type WALMarkerType `json:"type"`
+
+	// Database is the name of the database of the operation.
+	Database string `json:"db,omitempty"`
+
+	// CollectionGUID is the globally unique ID of the collection of the operation.
+	CollectionGUID string `json:"cuid,omitempty"`
+
+	// TransactionID is the ID of the transaction the operation belongs to. It is "0" for single operations.
+	TransactionID string `json:"tid,omitempty"`
+
+	// Data contains the details of the operation, e.g. the inserted, updated or replaced document for WALMarkerTypeDocument.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// DecodeData decodes the data of the marker into the given object.
+func (w WALMarker) DecodeData(out interface{}) error {
+	return json.Unmarshal(w.Data, out)
+}
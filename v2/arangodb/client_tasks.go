@@ -0,0 +1,102 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// ClientTasks provides access to the server-side tasks, which execute JavaScript code periodically or once.
+type ClientTasks interface {
+	// Tasks returns all tasks of the database.
+	Tasks(ctx context.Context, dbName string) ([]Task, error)
+
+	// Task returns the task with the given ID.
+	// If the task does not exist, a NotFoundError is returned.
+	Task(ctx context.Context, dbName string, id string) (Task, error)
+
+	// CreateTask creates a new task in the database.
+	// If TaskOptions.ID is set, then the task is created with this ID, otherwise the ID is generated by the server.
+	CreateTask(ctx context.Context, dbName string, options TaskOptions) (Task, error)
+
+	// RemoveTask removes the task with the given ID.
+	// If the task does not exist, a NotFoundError is returned.
+	RemoveTask(ctx context.Context, dbName string, id string) error
+}
+
+// TaskType is the type of the task.
+type TaskType string
+
+const (
+	// TaskTypePeriodic is the type of the task which is executed repeatedly.
+	TaskTypePeriodic TaskType = "periodic"
+	// TaskTypeTimed is the type of the task which is executed once.
+	TaskTypeTimed TaskType = "timed"
+)
+
+// TaskOptions contains the options for creating a task.
+type TaskOptions struct {
+	// ID is the ID of the task. If it is empty, then the ID is generated by the server.
+	ID string `json:"-"`
+
+	// Name is the name of the task.
+	Name string `json:"name,omitempty"`
+
+	// Command is the JavaScript code to execute, e.g. "(function(params) { require('@arangodb').print(params); })(params)".
+	Command string `json:"command"`
+
+	// Params are the parameters passed to the command as the `params` variable.
+	Params interface{} `json:"params,omitempty"`
+
+	// Period is the number of seconds between the executions of the task.
+	// If it is not set, then the task is executed once, after the Offset.
+	Period int64 `json:"period,omitempty"`
+
+	// Offset is the number of seconds to wait before the first execution of the task.
+	Offset float64 `json:"offset,omitempty"`
+}
+
+// Task is the server-side task.
+type Task struct {
+	// ID is the ID of the task.
+	ID string `json:"id"`
+
+	// Name is the name of the task.
+	Name string `json:"name,omitempty"`
+
+	// Type is the type of the task.
+	Type TaskType `json:"type,omitempty"`
+
+	// Period is the number of seconds between the executions of the task. It is set only for the periodic tasks.
+	Period int64 `json:"period,omitempty"`
+
+	// Offset is the number of seconds to wait before the first execution of the task.
+	Offset float64 `json:"offset,omitempty"`
+
+	// Created is the timestamp when the task was created, as the number of seconds since the Unix epoch.
+	Created float64 `json:"created,omitempty"`
+
+	// Command is the JavaScript code of the task.
+	Command string `json:"command,omitempty"`
+
+	// Database is the name of the database the task runs in.
+	Database string `json:"database,omitempty"`
+}
@@ -0,0 +1,126 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+var _ ClientTasks = &clientTasks{}
+
+type clientTasks struct {
+	client *client
+}
+
+func newClientTasks(client *client) *clientTasks {
+	return &clientTasks{
+		client: client,
+	}
+}
+
+// url creates the path to the tasks API of the database: `_db/<db-name>/_api/tasks/<parts>`.
+func (c *clientTasks) url(dbName string, parts ...string) string {
+	return connection.NewUrl(append([]string{"_db", url.PathEscape(dbName), "_api", "tasks"}, parts...)...)
+}
+
+func (c *clientTasks) Tasks(ctx context.Context, dbName string) ([]Task, error) {
+	var response []Task
+
+	_, err := connection.CallWithChecks(ctx, c.client.connection, http.MethodGet, c.url(dbName), &response, []int{http.StatusOK})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (c *clientTasks) Task(ctx context.Context, dbName string, id string) (Task, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Task                  `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, c.url(dbName, url.PathEscape(id)), &response)
+	if err != nil {
+		return Task{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Task, nil
+	default:
+		return Task{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientTasks) CreateTask(ctx context.Context, dbName string, options TaskOptions) (Task, error) {
+	if options.Command == "" {
+		return Task{}, errors.WithStack(shared.InvalidArgumentError{Message: "command of the task must be set"})
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Task                  `json:",inline"`
+	}
+
+	var resp connection.Response
+	var err error
+	if options.ID != "" {
+		resp, err = connection.CallPut(ctx, c.client.connection, c.url(dbName, url.PathEscape(options.ID)), &response, &options)
+	} else {
+		resp, err = connection.CallPost(ctx, c.client.connection, c.url(dbName), &response, &options)
+	}
+	if err != nil {
+		return Task{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Task, nil
+	default:
+		return Task{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c *clientTasks) RemoveTask(ctx context.Context, dbName string, id string) error {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, c.client.connection, c.url(dbName, url.PathEscape(id)), &response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
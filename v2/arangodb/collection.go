@@ -51,6 +51,47 @@ type Collection interface {
 	// Count fetches the number of document in the collection.
 	Count(ctx context.Context) (int64, error)
 
+	// Statistics returns the number of documents and additional statistical information about the collection.
+	// If details is set to true, then the figures contain the storage engine specific details.
+	Statistics(ctx context.Context, details bool) (CollectionStatistics, error)
+
+	// Revision fetches the revision ID of the collection.
+	// The revision ID is a server-generated string that clients can use to check whether data
+	// in a collection has changed since the last revision check.
+	Revision(ctx context.Context) (string, error)
+
+	// Checksum returns a checksum for the collection.
+	// withRevisions - Whether to include document revision ids in the checksum calculation.
+	// withData - Whether to include document body data in the checksum calculation.
+	Checksum(ctx context.Context, withRevisions bool, withData bool) (CollectionChecksum, error)
+
+	// Rename renames the collection (SINGLE server only) and returns the collection with the new name.
+	// If the collection does not exist, a NotFoundError is returned.
+	Rename(ctx context.Context, newName string) (Collection, error)
+
+	// Load the collection into memory.
+	//
+	// Deprecated: Since ArangoDB 3.9 the collection is always loaded, so this function has no effect.
+	Load(ctx context.Context) error
+
+	// Unload unloads the collection from memory.
+	//
+	// Deprecated: Since ArangoDB 3.9 the collection is always loaded, so this function has no effect.
+	Unload(ctx context.Context) error
+
+	// Compact compacts the data of the collection in order to reclaim disk space.
+	Compact(ctx context.Context) (CollectionInfo, error)
+
+	// RecalculateCount recalculates the document count of the collection, if it ever becomes inconsistent.
+	RecalculateCount(ctx context.Context) error
+
+	// ResponsibleShard returns the ID of the shard which is responsible for the given document.
+	// The document must contain the shard key attributes of the collection. Available in cluster only.
+	ResponsibleShard(ctx context.Context, document interface{}) (ShardID, error)
+
+	// LoadIndexesIntoMemory loads all indexes of the collection into memory.
+	LoadIndexesIntoMemory(ctx context.Context) error
+
 	CollectionDocuments
 	CollectionIndexes
 }
@@ -33,4 +33,5 @@ type CollectionDocuments interface {
 	CollectionDocumentUpdate
 	CollectionDocumentReplace
 	CollectionDocumentDelete
+	CollectionDocumentImport
 }
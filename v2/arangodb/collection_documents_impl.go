@@ -36,6 +36,7 @@ func newCollectionDocuments(collection *collection) *collectionDocuments {
 	d.collectionDocumentRead = newCollectionDocumentRead(d.collection)
 	d.collectionDocumentCreate = newCollectionDocumentCreate(d.collection)
 	d.collectionDocumentDelete = newCollectionDocumentDelete(d.collection)
+	d.collectionDocumentImport = newCollectionDocumentImport(d.collection)
 
 	return d
 }
@@ -52,6 +53,7 @@ type collectionDocuments struct {
 	*collectionDocumentRead
 	*collectionDocumentCreate
 	*collectionDocumentDelete
+	*collectionDocumentImport
 }
 
 func (c collectionDocuments) DocumentExists(ctx context.Context, key string) (bool, error) {
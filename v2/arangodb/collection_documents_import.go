@@ -0,0 +1,196 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+type CollectionDocumentImport interface {
+	// ImportDocuments imports the documents from the given slice into the collection.
+	// The documents are sent in batches, see CollectionDocumentImportOptions.MaxBatchSize.
+	ImportDocuments(ctx context.Context, documents interface{}, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error)
+
+	// ImportDocumentsFromReader imports the documents read from the given reader into the collection.
+	// The input is either JSON Lines (one document per line) or a JSON array of documents, see CollectionDocumentImportOptions.Type.
+	// The input is read and sent in batches, so it is never loaded into memory as a whole.
+	ImportDocumentsFromReader(ctx context.Context, reader io.Reader, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error)
+
+	// ImportDocumentsFromChannel imports the documents received from the given channel into the collection.
+	// The import is finished when the channel is closed.
+	ImportDocumentsFromChannel(ctx context.Context, documents <-chan interface{}, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error)
+}
+
+// CollectionDocumentImportType describes the format of the input for ImportDocumentsFromReader.
+type CollectionDocumentImportType string
+
+const (
+	// CollectionDocumentImportTypeDocuments - each line of the input is a single document (JSON Lines).
+	CollectionDocumentImportTypeDocuments CollectionDocumentImportType = "documents"
+
+	// CollectionDocumentImportTypeList - the input is a JSON array of documents.
+	CollectionDocumentImportTypeList CollectionDocumentImportType = "list"
+
+	// CollectionDocumentImportTypeAuto - the format is detected from the first character of the input (default).
+	CollectionDocumentImportTypeAuto CollectionDocumentImportType = "auto"
+)
+
+// CollectionDocumentImportOnDuplicate controls what action is carried out in case of a unique key constraint violation.
+type CollectionDocumentImportOnDuplicate string
+
+const (
+	// CollectionDocumentImportOnDuplicateError will not import the current document because of the unique key constraint violation.
+	// This is the default setting.
+	CollectionDocumentImportOnDuplicateError CollectionDocumentImportOnDuplicate = "error"
+	// CollectionDocumentImportOnDuplicateUpdate will update an existing document in the database with the data specified in the request.
+	// Attributes of the existing document that are not present in the request will be preserved.
+	CollectionDocumentImportOnDuplicateUpdate CollectionDocumentImportOnDuplicate = "update"
+	// CollectionDocumentImportOnDuplicateReplace will replace an existing document in the database with the data specified in the request.
+	CollectionDocumentImportOnDuplicateReplace CollectionDocumentImportOnDuplicate = "replace"
+	// CollectionDocumentImportOnDuplicateIgnore will not update an existing document and simply ignore the error caused by a unique key constraint violation.
+	CollectionDocumentImportOnDuplicateIgnore CollectionDocumentImportOnDuplicate = "ignore"
+)
+
+// defaultImportMaxBatchSize is the default maximum size of the request body of a single import request.
+const defaultImportMaxBatchSize = 8 * 1024 * 1024
+
+type CollectionDocumentImportOptions struct {
+	// Type is the format of the input for ImportDocumentsFromReader. Default: CollectionDocumentImportTypeAuto.
+	Type CollectionDocumentImportType
+
+	// FromPrefix is an optional prefix for the values in _from attributes. If specified, the value is automatically
+	// prepended to each _from input value. This allows specifying just the keys for _from.
+	FromPrefix string
+
+	// ToPrefix is an optional prefix for the values in _to attributes. If specified, the value is automatically
+	// prepended to each _to input value. This allows specifying just the keys for _to.
+	ToPrefix string
+
+	// Overwrite if set to true, then all data in the collection is removed prior to the import.
+	// Note that any existing index definitions are preserved.
+	Overwrite *bool
+
+	// WithWaitForSync waits until the documents have been synced to disk.
+	WithWaitForSync *bool
+
+	// OnDuplicate controls what action is carried out in case of a unique key constraint violation.
+	OnDuplicate CollectionDocumentImportOnDuplicate
+
+	// Complete if set to true, makes the import of a batch fail if any error occurs in it.
+	// Otherwise, the import continues even if some documents cannot be imported.
+	// Batches which were sent before the failing one remain imported.
+	Complete *bool
+
+	// Details if set to true, the statistics contain an error for every document that could not be imported.
+	Details *bool
+
+	// MaxBatchSize is the maximum size (in bytes) of the documents sent in one request.
+	// A document which is bigger than this size is sent in a request on its own. Default: 8 MiB.
+	MaxBatchSize int
+}
+
+func (c *CollectionDocumentImportOptions) getType() CollectionDocumentImportType {
+	if c == nil || c.Type == "" {
+		return CollectionDocumentImportTypeAuto
+	}
+
+	return c.Type
+}
+
+func (c *CollectionDocumentImportOptions) getMaxBatchSize() int {
+	if c == nil || c.MaxBatchSize <= 0 {
+		return defaultImportMaxBatchSize
+	}
+
+	return c.MaxBatchSize
+}
+
+// modifyRequest sets the query parameters of the import request.
+// The overwrite option is applied only to the first batch, otherwise each batch would remove the previous ones.
+func (c *CollectionDocumentImportOptions) modifyRequest(firstBatch bool) connection.RequestModifier {
+	return func(r connection.Request) error {
+		if c == nil {
+			return nil
+		}
+
+		if c.FromPrefix != "" {
+			r.AddQuery("fromPrefix", c.FromPrefix)
+		}
+
+		if c.ToPrefix != "" {
+			r.AddQuery("toPrefix", c.ToPrefix)
+		}
+
+		if c.Overwrite != nil && firstBatch {
+			r.AddQuery("overwrite", boolToString(*c.Overwrite))
+		}
+
+		if c.WithWaitForSync != nil {
+			r.AddQuery(QueryWaitForSync, boolToString(*c.WithWaitForSync))
+		}
+
+		if c.OnDuplicate != "" {
+			r.AddQuery("onDuplicate", string(c.OnDuplicate))
+		}
+
+		if c.Complete != nil {
+			r.AddQuery("complete", boolToString(*c.Complete))
+		}
+
+		if c.Details != nil {
+			r.AddQuery("details", boolToString(*c.Details))
+		}
+
+		return nil
+	}
+}
+
+// CollectionDocumentImportStatistics holds statistics of an import action.
+type CollectionDocumentImportStatistics struct {
+	// Created holds the number of documents imported.
+	Created int64 `json:"created,omitempty"`
+	// Errors holds the number of documents that were not imported due to an error.
+	Errors int64 `json:"errors,omitempty"`
+	// Empty holds the number of empty lines found in the input.
+	Empty int64 `json:"empty,omitempty"`
+	// Updated holds the number of updated/replaced documents (in case onDuplicate was set to either update or replace).
+	Updated int64 `json:"updated,omitempty"`
+	// Ignored holds the number of failed but ignored insert operations (in case onDuplicate was set to ignore).
+	Ignored int64 `json:"ignored,omitempty"`
+
+	// LineErrors contains an entry for every document which could not be imported.
+	// It is filled only when the Details option is set.
+	LineErrors []CollectionDocumentImportLineError `json:"-"`
+}
+
+// CollectionDocumentImportLineError describes why a document could not be imported.
+type CollectionDocumentImportLineError struct {
+	// Line is the 1-based number of the document in the input: the line for JSON Lines input,
+	// the position in the array or in the slice, or the number of the document received from the channel.
+	// It is 0 if the server reported an error which could not be assigned to a document.
+	Line int64
+
+	// Message is the error message reported by the server.
+	Message string
+}
@@ -0,0 +1,318 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func newCollectionDocumentImport(collection *collection) *collectionDocumentImport {
+	return &collectionDocumentImport{
+		collection: collection,
+	}
+}
+
+var _ CollectionDocumentImport = &collectionDocumentImport{}
+
+type collectionDocumentImport struct {
+	collection *collection
+}
+
+// importLineFunc returns the next document of the input and its 1-based number in the input.
+// It returns io.EOF when there are no more documents.
+type importLineFunc func() ([]byte, int64, error)
+
+func (c collectionDocumentImport) ImportDocuments(ctx context.Context, documents interface{}, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error) {
+	if !utils.IsListPtr(documents) && !utils.IsList(documents) {
+		return CollectionDocumentImportStatistics{}, errors.Errorf("Input documents should be list")
+	}
+
+	list := reflect.Indirect(reflect.ValueOf(documents))
+	var i int
+
+	return c.importLines(ctx, func() ([]byte, int64, error) {
+		if i >= list.Len() {
+			return nil, 0, io.EOF
+		}
+
+		i++
+		data, err := json.Marshal(list.Index(i - 1).Interface())
+		return data, int64(i), errors.WithStack(err)
+	}, opts)
+}
+
+func (c collectionDocumentImport) ImportDocumentsFromReader(ctx context.Context, reader io.Reader, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error) {
+	r := bufio.NewReader(reader)
+
+	importType := opts.getType()
+	if importType == CollectionDocumentImportTypeAuto {
+		var err error
+		if importType, err = detectImportType(r); err != nil {
+			return CollectionDocumentImportStatistics{}, err
+		}
+	}
+
+	switch importType {
+	case CollectionDocumentImportTypeDocuments:
+		return c.importLines(ctx, newImportDocumentsLineFunc(r), opts)
+	case CollectionDocumentImportTypeList:
+		next, err := newImportListLineFunc(r)
+		if err != nil {
+			return CollectionDocumentImportStatistics{}, err
+		}
+		return c.importLines(ctx, next, opts)
+	default:
+		return CollectionDocumentImportStatistics{}, errors.WithStack(shared.InvalidArgumentError{Message: "unknown import type " + string(importType)})
+	}
+}
+
+func (c collectionDocumentImport) ImportDocumentsFromChannel(ctx context.Context, documents <-chan interface{}, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error) {
+	var i int64
+
+	return c.importLines(ctx, func() ([]byte, int64, error) {
+		select {
+		case <-ctx.Done():
+			return nil, 0, errors.WithStack(ctx.Err())
+		case document, ok := <-documents:
+			if !ok {
+				return nil, 0, io.EOF
+			}
+
+			i++
+			data, err := json.Marshal(document)
+			return data, i, errors.WithStack(err)
+		}
+	}, opts)
+}
+
+// importLines groups the documents into JSON Lines batches and sends them to the server one after another.
+func (c collectionDocumentImport) importLines(ctx context.Context, next importLineFunc, opts *CollectionDocumentImportOptions) (CollectionDocumentImportStatistics, error) {
+	var stats CollectionDocumentImportStatistics
+	var batch importBatch
+
+	maxBatchSize := opts.getMaxBatchSize()
+	firstBatch := true
+
+	flush := func() error {
+		if batch.buffer.Len() == 0 {
+			return nil
+		}
+
+		err := c.importBatch(ctx, &batch, opts.modifyRequest(firstBatch), &stats)
+		firstBatch = false
+		batch.reset()
+
+		return err
+	}
+
+	for {
+		line, lineNumber, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stats, err
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			stats.Empty++
+			continue
+		}
+
+		if batch.buffer.Len() > 0 && batch.buffer.Len()+len(line)+1 > maxBatchSize {
+			if err := flush(); err != nil {
+				return stats, err
+			}
+		}
+
+		batch.add(line, lineNumber)
+	}
+
+	return stats, flush()
+}
+
+func (c collectionDocumentImport) importBatch(ctx context.Context, batch *importBatch, mod connection.RequestModifier, stats *CollectionDocumentImportStatistics) error {
+	urlEndpoint := c.collection.db.url("_api", "import")
+
+	var response struct {
+		shared.ResponseStruct              `json:",inline"`
+		CollectionDocumentImportStatistics `json:",inline"`
+		Details                            []string `json:"details,omitempty"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.collection.connection(), urlEndpoint, &response, batch.buffer.Bytes(),
+		c.collection.withModifiers(mod,
+			connection.WithQuery("collection", c.collection.name),
+			connection.WithQuery("type", string(CollectionDocumentImportTypeDocuments)),
+			connection.WithHeader(connection.ContentType, connection.PlainText))...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		stats.Created += response.Created
+		stats.Errors += response.Errors
+		stats.Empty += response.Empty
+		stats.Updated += response.Updated
+		stats.Ignored += response.Ignored
+
+		for _, detail := range response.Details {
+			stats.LineErrors = append(stats.LineErrors, batch.lineError(detail))
+		}
+
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+// importBatch holds the documents of a single import request.
+type importBatch struct {
+	buffer bytes.Buffer
+
+	// lineNumbers maps the position of a document in the batch to its number in the input.
+	lineNumbers []int64
+}
+
+func (b *importBatch) add(line []byte, lineNumber int64) {
+	b.buffer.Write(line)
+	b.buffer.WriteByte('\n')
+	b.lineNumbers = append(b.lineNumbers, lineNumber)
+}
+
+func (b *importBatch) reset() {
+	b.buffer.Reset()
+	b.lineNumbers = b.lineNumbers[:0]
+}
+
+// importDetailPosition matches the prefix of the error details reported by the server, e.g. "at position 1: ...".
+// The positions are counted from 0.
+var importDetailPosition = regexp.MustCompile(`^at position (\d+): `)
+
+// lineError converts the error details reported by the server for the batch into an error for the input line.
+func (b *importBatch) lineError(detail string) CollectionDocumentImportLineError {
+	match := importDetailPosition.FindStringSubmatch(detail)
+	if match == nil {
+		return CollectionDocumentImportLineError{Message: detail}
+	}
+
+	lineError := CollectionDocumentImportLineError{Message: detail[len(match[0]):]}
+	if position, err := strconv.Atoi(match[1]); err == nil && position >= 0 && position < len(b.lineNumbers) {
+		lineError.Line = b.lineNumbers[position]
+	}
+
+	return lineError
+}
+
+// detectImportType returns the type of the input based on its first non-whitespace character.
+func detectImportType(r *bufio.Reader) (CollectionDocumentImportType, error) {
+	for {
+		c, err := r.ReadByte()
+		if err == io.EOF {
+			return CollectionDocumentImportTypeDocuments, nil
+		}
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '[':
+			return CollectionDocumentImportTypeList, errors.WithStack(r.UnreadByte())
+		default:
+			return CollectionDocumentImportTypeDocuments, errors.WithStack(r.UnreadByte())
+		}
+	}
+}
+
+// newImportDocumentsLineFunc reads the documents from JSON Lines input.
+func newImportDocumentsLineFunc(r *bufio.Reader) importLineFunc {
+	var lineNumber int64
+
+	return func() ([]byte, int64, error) {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF && len(line) > 0 {
+			err = nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		lineNumber++
+		return line, lineNumber, nil
+	}
+}
+
+// newImportListLineFunc reads the documents from a JSON array one by one.
+func newImportListLineFunc(r io.Reader) (importLineFunc, error) {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.WithStack(shared.InvalidArgumentError{Message: "input is not a JSON array"})
+	}
+
+	var position int64
+
+	return func() ([]byte, int64, error) {
+		if !decoder.More() {
+			if _, err := decoder.Token(); err != nil {
+				return nil, 0, errors.WithStack(err)
+			}
+			return nil, 0, io.EOF
+		}
+
+		var document json.RawMessage
+		if err := decoder.Decode(&document); err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+
+		// The document is sent as a single line, so it can not contain new lines.
+		var line bytes.Buffer
+		if err := json.Compact(&line, document); err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+
+		position++
+		return line.Bytes(), position, nil
+	}, nil
+}
@@ -0,0 +1,90 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readImportLines(t *testing.T, next importLineFunc) ([]string, []int64) {
+	var lines []string
+	var numbers []int64
+	for {
+		line, number, err := next()
+		if err == io.EOF {
+			return lines, numbers
+		}
+		require.NoError(t, err)
+		lines = append(lines, strings.TrimSpace(string(line)))
+		numbers = append(numbers, number)
+	}
+}
+
+func TestImportDocumentsLineFunc(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("{\"a\":1}\n\n{\"a\":2}"))
+
+	importType, err := detectImportType(r)
+	require.NoError(t, err)
+	require.Equal(t, CollectionDocumentImportTypeDocuments, importType)
+
+	lines, numbers := readImportLines(t, newImportDocumentsLineFunc(r))
+	assert.Equal(t, []string{`{"a":1}`, ``, `{"a":2}`}, lines)
+	assert.Equal(t, []int64{1, 2, 3}, numbers)
+}
+
+func TestImportListLineFunc(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader(" \n[\n  {\"a\": 1},\n  {\n    \"a\": 2\n  }\n]"))
+
+	importType, err := detectImportType(r)
+	require.NoError(t, err)
+	require.Equal(t, CollectionDocumentImportTypeList, importType)
+
+	next, err := newImportListLineFunc(r)
+	require.NoError(t, err)
+
+	lines, numbers := readImportLines(t, next)
+	assert.Equal(t, []string{`{"a":1}`, `{"a":2}`}, lines)
+	assert.Equal(t, []int64{1, 2}, numbers)
+
+	_, err = newImportListLineFunc(strings.NewReader(`{"a": 1}`))
+	require.Error(t, err)
+}
+
+func TestImportBatchLineError(t *testing.T) {
+	var batch importBatch
+	batch.add([]byte(`{"a":1}`), 4)
+	batch.add([]byte(`{"a":2}`), 7)
+
+	assert.Equal(t, CollectionDocumentImportLineError{Line: 4, Message: "unique constraint violated"},
+		batch.lineError("at position 0: unique constraint violated"))
+	assert.Equal(t, CollectionDocumentImportLineError{Line: 7, Message: "unique constraint violated"},
+		batch.lineError("at position 1: unique constraint violated"))
+	assert.Equal(t, CollectionDocumentImportLineError{Message: "unique constraint violated"},
+		batch.lineError("at position 2: unique constraint violated"))
+	assert.Equal(t, CollectionDocumentImportLineError{Message: "unexpected error"},
+		batch.lineError("unexpected error"))
+}
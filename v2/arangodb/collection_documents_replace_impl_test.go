@@ -0,0 +1,51 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceDocumentsMethod(t *testing.T) {
+	var requests testRequestLog
+	col := newCollection(newTestDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.add(r)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`[{"_key":"a"}]`))
+	})), "col")
+
+	r, err := col.ReplaceDocuments(context.Background(), []map[string]interface{}{{"_key": "a"}})
+	require.NoError(t, err)
+
+	meta, err := r.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "a", meta.Key)
+
+	// The documents are replaced with PUT, PATCH would update them.
+	assert.Equal(t, []string{"PUT /_db/db/_api/document/col"}, requests.all())
+}
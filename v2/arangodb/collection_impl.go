@@ -118,6 +118,170 @@ func (c collection) Count(ctx context.Context) (int64, error) {
 	}
 }
 
+func (c collection) Statistics(ctx context.Context, details bool) (CollectionStatistics, error) {
+	urlEndpoint := c.url("collection", "figures")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		CollectionStatistics  `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.connection(), urlEndpoint, &response,
+		c.withModifiers(connection.WithQuery("details", boolToString(details)))...)
+	if err != nil {
+		return CollectionStatistics{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.CollectionStatistics, nil
+	default:
+		return CollectionStatistics{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) Revision(ctx context.Context) (string, error) {
+	urlEndpoint := c.url("collection", "revision")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Revision              string `json:"revision,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.connection(), urlEndpoint, &response, c.withModifiers()...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Revision, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) Checksum(ctx context.Context, withRevisions bool, withData bool) (CollectionChecksum, error) {
+	urlEndpoint := c.url("collection", "checksum")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		CollectionChecksum    `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.connection(), urlEndpoint, &response,
+		c.withModifiers(connection.WithQuery("withRevisions", boolToString(withRevisions)),
+			connection.WithQuery("withData", boolToString(withData)))...)
+	if err != nil {
+		return CollectionChecksum{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.CollectionChecksum, nil
+	default:
+		return CollectionChecksum{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) Rename(ctx context.Context, newName string) (Collection, error) {
+	urlEndpoint := c.url("collection", "rename")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		CollectionInfo        `json:",inline"`
+	}
+
+	body := struct {
+		Name string `json:"name"`
+	}{
+		Name: newName,
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, body, c.withModifiers()...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		// The modifiers of the database are added again by the constructor.
+		return newCollection(c.db, response.Name, c.modifiers[len(c.db.modifiers):]...), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) Load(ctx context.Context) error {
+	return c.callMaintenance(ctx, "load", nil)
+}
+
+func (c collection) Unload(ctx context.Context) error {
+	return c.callMaintenance(ctx, "unload", nil)
+}
+
+func (c collection) Compact(ctx context.Context) (CollectionInfo, error) {
+	var info CollectionInfo
+
+	err := c.callMaintenance(ctx, "compact", &info)
+	return info, err
+}
+
+func (c collection) RecalculateCount(ctx context.Context) error {
+	return c.callMaintenance(ctx, "recalculateCount", nil)
+}
+
+func (c collection) LoadIndexesIntoMemory(ctx context.Context) error {
+	return c.callMaintenance(ctx, "loadIndexesIntoMemory", nil)
+}
+
+// callMaintenance calls the maintenance operation on the collection which does not require any body.
+// If the info is not nil, then it is populated with the collection information returned by the server.
+func (c collection) callMaintenance(ctx context.Context, operation string, info *CollectionInfo) error {
+	urlEndpoint := c.url("collection", operation)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		CollectionInfo        `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, struct{}{}, c.withModifiers()...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		if info != nil {
+			*info = response.CollectionInfo
+		}
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c collection) ResponsibleShard(ctx context.Context, document interface{}) (ShardID, error) {
+	urlEndpoint := c.url("collection", "responsibleShard")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ShardID               ShardID `json:"shardId,omitempty"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.connection(), urlEndpoint, &response, document, c.withModifiers()...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ShardID, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
 func (c collection) Properties(ctx context.Context) (CollectionProperties, error) {
 	urlEndpoint := c.url("collection", "properties")
 
@@ -350,9 +350,35 @@ type CollectionStatistics struct {
 			// The memory used for storing the revisions of this collection in the storage engine (in bytes). This figure does not include the document data but only mappings from document revision ids to storage engine datafile positions.
 			Size int64 `json:"size,omitempty"`
 		} `json:"revisions"`
+
+		// The approximate total size of the documents in the collection (in bytes).
+		DocumentsSize *int64 `json:"documentsSize,omitempty"`
+
+		// Whether the document cache is enabled for the collection.
+		CacheInUse *bool `json:"cacheInUse,omitempty"`
+
+		// The total memory reserved for the document cache of the collection (in bytes).
+		CacheSize *int64 `json:"cacheSize,omitempty"`
+
+		// The total memory used by the document cache of the collection (in bytes).
+		CacheUsage *int64 `json:"cacheUsage,omitempty"`
+
+		// Engine contains the storage engine specific details. It is set only if the details were requested.
+		Engine map[string]interface{} `json:"engine,omitempty"`
 	} `json:"figures"`
 }
 
+// CollectionChecksum contains information about a collection checksum response
+type CollectionChecksum struct {
+	CollectionInfo
+
+	// The collection revision id as a string.
+	Revision string `json:"revision,omitempty"`
+
+	// The calculated checksum as a number.
+	Checksum string `json:"checksum,omitempty"`
+}
+
 // CollectionShards contains shards information about a collection.
 type CollectionShards struct {
 	CollectionExtendedInfo
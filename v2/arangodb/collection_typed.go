@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// TypedCollection provides the type-safe access to the documents of type T in the collection.
+// The documents are decoded into T, so the mismatched types are detected at compile time
+// instead of the runtime checks of the untyped Collection methods.
+type TypedCollection[T any] interface {
+	// Collection returns the underlying untyped collection.
+	Collection() Collection
+
+	// Read reads a single document with given key from the collection.
+	// If no document exists with given key, a NotFoundError is returned.
+	Read(ctx context.Context, key string) (T, DocumentMeta, error)
+
+	// ReadWithOptions reads a single document with given key from the collection.
+	// If no document exists with given key, a NotFoundError is returned.
+	ReadWithOptions(ctx context.Context, key string, opts *CollectionDocumentReadOptions) (T, DocumentMeta, error)
+
+	// ReadMany reads multiple documents with given keys from the collection.
+	// The responses are in the order of the keys. If no document exists with a given key,
+	// the error is set in the ResponseStruct of its response.
+	ReadMany(ctx context.Context, keys []string) ([]TypedCollectionDocumentReadResponse[T], error)
+
+	// Create creates a single document in the collection.
+	// A ConflictError is returned when a `_key` field contains a duplicate key, other any other field violates an index constraint.
+	Create(ctx context.Context, document T) (TypedCollectionDocumentCreateResponse[T], error)
+
+	// CreateWithOptions creates a single document in the collection.
+	// The NewObject and OldObject options are used only as the flags: if they are set, the New and Old fields
+	// of the response are filled with the documents of type T.
+	CreateWithOptions(ctx context.Context, document T, opts *CollectionDocumentCreateOptions) (TypedCollectionDocumentCreateResponse[T], error)
+
+	// CreateMany creates multiple documents in the collection.
+	// The responses are in the order of the documents. If a document can not be created,
+	// e.g. because of a duplicate key, the error is set in the ResponseStruct of its response.
+	// If the create request itself fails, an error is returned.
+	CreateMany(ctx context.Context, documents []T) ([]TypedCollectionDocumentCreateResponse[T], error)
+
+	// CreateManyWithOptions creates multiple documents in the collection.
+	// The NewObject and OldObject options are used only as the flags: if they are set, the New and Old fields
+	// of the responses are filled with the documents of type T.
+	CreateManyWithOptions(ctx context.Context, documents []T, opts *CollectionDocumentCreateOptions) ([]TypedCollectionDocumentCreateResponse[T], error)
+}
+
+// TypedCollectionDocumentReadResponse is the response of a single document read with TypedCollection.ReadMany.
+type TypedCollectionDocumentReadResponse[T any] struct {
+	DocumentMeta
+	shared.ResponseStruct
+
+	// Document is the read document. It is the zero value if the document could not be read.
+	Document T
+}
+
+// TypedCollectionDocumentCreateResponse is the response of a single document created with TypedCollection.
+type TypedCollectionDocumentCreateResponse[T any] struct {
+	DocumentMeta
+	shared.ResponseStruct
+
+	// Old and New are set only if they have been requested with the options and returned by the server.
+	Old, New *T
+}
+
+// NewTypedCollection returns the type-safe access to the documents of type T in the given collection.
+func NewTypedCollection[T any](col Collection) TypedCollection[T] {
+	return &typedCollection[T]{collection: col}
+}
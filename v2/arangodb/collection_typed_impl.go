@@ -0,0 +1,179 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+var _ TypedCollection[any] = &typedCollection[any]{}
+
+type typedCollection[T any] struct {
+	collection Collection
+}
+
+func (t *typedCollection[T]) Collection() Collection {
+	return t.collection
+}
+
+func (t *typedCollection[T]) Read(ctx context.Context, key string) (T, DocumentMeta, error) {
+	return t.ReadWithOptions(ctx, key, nil)
+}
+
+func (t *typedCollection[T]) ReadWithOptions(ctx context.Context, key string, opts *CollectionDocumentReadOptions) (T, DocumentMeta, error) {
+	var document T
+
+	meta, err := t.collection.ReadDocumentWithOptions(ctx, key, &document, opts)
+	if err != nil {
+		var zero T
+		return zero, DocumentMeta{}, err
+	}
+
+	return document, meta, nil
+}
+
+func (t *typedCollection[T]) ReadMany(ctx context.Context, keys []string) ([]TypedCollectionDocumentReadResponse[T], error) {
+	reader, err := t.collection.ReadDocuments(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]TypedCollectionDocumentReadResponse[T], 0, len(keys))
+	for response, err := range CollectionDocumentReadResponseSeq[T](reader) {
+		if err != nil {
+			if ok, _ := shared.IsArangoError(err); !ok {
+				return nil, err
+			}
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (t *typedCollection[T]) Create(ctx context.Context, document T) (TypedCollectionDocumentCreateResponse[T], error) {
+	return t.CreateWithOptions(ctx, document, nil)
+}
+
+func (t *typedCollection[T]) CreateWithOptions(ctx context.Context, document T, opts *CollectionDocumentCreateOptions) (TypedCollectionDocumentCreateResponse[T], error) {
+	opts, result := newTypedCreateOptions[T](opts)
+
+	meta, err := t.collection.CreateDocumentWithOptions(ctx, document, opts)
+	if err != nil {
+		return TypedCollectionDocumentCreateResponse[T]{}, err
+	}
+
+	return result.response(meta), nil
+}
+
+func (t *typedCollection[T]) CreateMany(ctx context.Context, documents []T) ([]TypedCollectionDocumentCreateResponse[T], error) {
+	return t.CreateManyWithOptions(ctx, documents, nil)
+}
+
+func (t *typedCollection[T]) CreateManyWithOptions(ctx context.Context, documents []T, opts *CollectionDocumentCreateOptions) ([]TypedCollectionDocumentCreateResponse[T], error) {
+	if documents == nil {
+		documents = []T{}
+	}
+
+	opts, result := newTypedCreateOptions[T](opts)
+
+	reader, err := t.collection.CreateDocumentsWithOptions(ctx, documents, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]TypedCollectionDocumentCreateResponse[T], 0, len(documents))
+	for meta, err := range CollectionDocumentCreateResponseSeq(reader) {
+		if err != nil {
+			if ok, _ := shared.IsArangoError(err); !ok {
+				return nil, err
+			}
+		}
+
+		responses = append(responses, result.response(meta))
+	}
+
+	return responses, nil
+}
+
+// typedCreateResult receives the old and new documents of the create responses.
+// The same objects are used by the reader for each document, so they are moved to the response and reset after each response.
+type typedCreateResult[T any] struct {
+	old, new *typedObject[T]
+}
+
+// typedObject is the document of type T which remembers whether it has been returned by the server.
+type typedObject[T any] struct {
+	value T
+	set   bool
+}
+
+func (t *typedObject[T]) UnmarshalJSON(d []byte) error {
+	if err := json.Unmarshal(d, &t.value); err != nil {
+		return err
+	}
+	t.set = true
+	return nil
+}
+
+// take returns the received document and resets the object. It returns nil if the document has not been received.
+func (t *typedObject[T]) take() *T {
+	if t == nil || !t.set {
+		return nil
+	}
+
+	v := t.value
+	*t = typedObject[T]{}
+	return &v
+}
+
+// newTypedCreateOptions returns the copy of the options in which the NewObject and OldObject are replaced with the objects of type T.
+func newTypedCreateOptions[T any](opts *CollectionDocumentCreateOptions) (*CollectionDocumentCreateOptions, *typedCreateResult[T]) {
+	result := &typedCreateResult[T]{}
+	if opts == nil {
+		return nil, result
+	}
+
+	o := *opts
+	if o.NewObject != nil {
+		result.new = &typedObject[T]{}
+		o.NewObject = result.new
+	}
+	if o.OldObject != nil {
+		result.old = &typedObject[T]{}
+		o.OldObject = result.old
+	}
+
+	return &o, result
+}
+
+func (r *typedCreateResult[T]) response(meta CollectionDocumentCreateResponse) TypedCollectionDocumentCreateResponse[T] {
+	return TypedCollectionDocumentCreateResponse[T]{
+		DocumentMeta:   meta.DocumentMeta,
+		ResponseStruct: meta.ResponseStruct,
+		Old:            r.old.take(),
+		New:            r.new.take(),
+	}
+}
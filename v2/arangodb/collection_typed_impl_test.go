@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedCreateResult(t *testing.T) {
+	type doc struct {
+		Name string            `json:"name"`
+		Tags map[string]string `json:"tags,omitempty"`
+	}
+
+	opts := &CollectionDocumentCreateOptions{NewObject: &doc{}}
+	typedOpts, result := newTypedCreateOptions[doc](opts)
+	require.NotSame(t, opts, typedOpts)
+	assert.IsType(t, &doc{}, opts.NewObject, "options of the caller must not be modified")
+	assert.Nil(t, typedOpts.OldObject)
+
+	into := newUnmarshalInto(typedOpts.NewObject)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"a","tags":{"x":"1"}}`), into))
+	first := result.response(CollectionDocumentCreateResponse{DocumentMeta: DocumentMeta{Key: "a"}})
+	require.NotNil(t, first.New)
+	assert.Nil(t, first.Old)
+	assert.Equal(t, doc{Name: "a", Tags: map[string]string{"x": "1"}}, *first.New)
+
+	second := result.response(CollectionDocumentCreateResponse{})
+	assert.Nil(t, second.New, "new document has not been returned for the second response")
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"b","tags":{"y":"2"}}`), into))
+	third := result.response(CollectionDocumentCreateResponse{})
+	require.NotNil(t, third.New)
+	assert.Equal(t, doc{Name: "b", Tags: map[string]string{"y": "2"}}, *third.New, "documents must not be merged")
+	assert.Equal(t, doc{Name: "a", Tags: map[string]string{"x": "1"}}, *first.New)
+
+	typedOpts, _ = newTypedCreateOptions[doc](nil)
+	assert.Nil(t, typedOpts)
+}
@@ -53,6 +53,9 @@ type Cursor interface {
 
 	// Plan returns the query execution plan for this cursor.
 	Plan() CursorPlan
+
+	// Cached returns true if the result of the query was served from the query results cache.
+	Cached() bool
 }
 
 // CursorBatch is returned from a query, used to iterate over a list of documents.
@@ -87,6 +90,9 @@ type CursorBatch interface {
 
 	// Plan returns the query execution plan for this cursor.
 	Plan() CursorPlan
+
+	// Cached returns true if the result of the query was served from the query results cache.
+	Cached() bool
 }
 
 type CursorStats struct {
@@ -130,6 +136,7 @@ type cursorData struct {
 	Result      jsonReader `json:"result,omitempty"`      // a stream of result documents (might be empty if query has no results)
 	NextBatchID string     `json:"nextBatchId,omitempty"` // id of the next batch of the cursor on the server when `allowRetry` option is true
 	HasMore     bool       `json:"hasMore,omitempty"`     // A boolean indicator whether there are more results available for the cursor on the server
+	Cached      bool       `json:"cached,omitempty"`      // A boolean flag indicating whether the query result was served from the query results cache
 	Extra       struct {
 		Stats CursorStats `json:"stats,omitempty"`
 		// Plan describes plan for a cursor.
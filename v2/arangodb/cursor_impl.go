@@ -32,6 +32,9 @@ import (
 	"github.com/arangodb/go-driver/v2/connection"
 )
 
+// cursorFirstBatchID is the ID of the batch returned with the created cursor, if the cursor allows retries.
+const cursorFirstBatchID = "1"
+
 func newCursor(db *database, endpoint string, data cursorData) *cursor {
 	c := &cursor{
 		db:       db,
@@ -42,7 +45,7 @@ func newCursor(db *database, endpoint string, data cursorData) *cursor {
 	if data.NextBatchID != "" {
 		c.retryData = &retryData{
 			cursorID:       data.ID,
-			currentBatchID: "1",
+			currentBatchID: cursorFirstBatchID,
 		}
 	}
 
@@ -58,6 +61,24 @@ type cursor struct {
 	data      cursorData
 	lock      sync.Mutex
 	retryData *retryData
+
+	// prefetchEnabled is set when the cursor has been created with QueryOptions.Prefetch.
+	prefetchEnabled bool
+	// prefetch is the request of the next batch which is running in the background.
+	// It is set only if the current batch has been fetched successfully and the cursor has more batches.
+	prefetch *cursorPrefetch
+}
+
+// cursorPrefetch is the request of the next batch of the cursor.
+type cursorPrefetch struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// batchID is the ID of the prefetched batch. It is empty if the cursor does not allow retries.
+	batchID string
+
+	data cursorData
+	err  error
 }
 
 type retryData struct {
@@ -77,6 +98,8 @@ func (c *cursor) CloseWithContext(ctx context.Context) error {
 		return nil
 	}
 
+	c.stopPrefetch()
+
 	if c.data.ID == "" {
 		c.closed = true
 		c.data = cursorData{}
@@ -85,7 +108,7 @@ func (c *cursor) CloseWithContext(ctx context.Context) error {
 
 	url := c.db.url("_api", "cursor", c.data.ID)
 
-	resp, err := connection.CallDelete(ctx, c.db.connection(), url, &c.data, c.db.modifiers...)
+	resp, err := connection.CallWithEndpoint(ctx, c.db.connection(), c.endpoint, http.MethodDelete, url, &c.data, c.db.modifiers...)
 	if err != nil {
 		return err
 	}
@@ -116,6 +139,9 @@ func (c *cursor) ReadDocument(ctx context.Context, result interface{}) (Document
 }
 
 func (c *cursor) ReadNextBatch(ctx context.Context, result interface{}) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	err := c.getNextBatch(ctx, "")
 	if err != nil {
 		return err
@@ -125,6 +151,15 @@ func (c *cursor) ReadNextBatch(ctx context.Context, result interface{}) error {
 }
 
 func (c *cursor) RetryReadBatch(ctx context.Context, result interface{}) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.prefetch != nil {
+		// The next batch has been already requested, so the server can not return the current batch again.
+		// The current batch has been fetched successfully, so it is returned from memory.
+		return json.Unmarshal(c.data.Result.in, result)
+	}
+
 	err := c.getNextBatch(ctx, c.retryData.currentBatchID)
 	if err != nil {
 		return err
@@ -167,34 +202,142 @@ func (c *cursor) getNextBatch(ctx context.Context, retryBatchID string) error {
 		return errors.WithStack(shared.NoMoreDocumentsError{})
 	}
 
-	url := c.db.url("_api", "cursor", c.data.ID)
-	// If we have a NextBatchID, use it
-	if c.data.NextBatchID != "" {
-		url = c.db.url("_api", "cursor", c.data.ID, c.data.NextBatchID)
+	if retryBatchID == "" && c.prefetch != nil {
+		return c.takePrefetch(ctx)
 	}
+
+	url, batchID := c.nextBatchURL()
 	// We have to retry the batch instead of fetching the next one
 	if retryBatchID != "" {
-		url = c.db.url("_api", "cursor", c.retryData.cursorID, retryBatchID)
+		url = cursorBatchURL(c.db, c.retryData.cursorID, retryBatchID)
 	}
 
 	// Update currentBatchID before fetching the next batch (no retry case)
-	if c.data.NextBatchID != "" && retryBatchID == "" {
-		c.retryData.currentBatchID = c.data.NextBatchID
+	if batchID != "" && retryBatchID == "" {
+		c.retryData.currentBatchID = batchID
+	}
+
+	data, err := c.fetchBatch(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	c.data = data
+	c.startPrefetch(ctx)
+	return nil
+}
+
+// nextBatchURL returns the URL of the next batch and its ID. The ID is empty if the cursor does not allow retries.
+func (c *cursor) nextBatchURL() (string, string) {
+	return cursorBatchURL(c.db, c.data.ID, c.data.NextBatchID), c.data.NextBatchID
+}
+
+// cursorBatchURL returns the URL of the batch with the given ID. The batch with the ID can be requested again
+// if the cursor allows retries. If the ID is empty, the URL of the next batch of the cursor is returned.
+func cursorBatchURL(db *database, cursorID, batchID string) string {
+	if batchID != "" {
+		return db.url("_api", "cursor", cursorID, batchID)
 	}
 
+	return db.url("_api", "cursor", cursorID)
+}
+
+// fetchBatch requests the batch of the cursor. It does not modify the cursor, so it can be called in the background.
+// The request is sent to the endpoint which has created the cursor, because the cursor exists only there.
+func (c *cursor) fetchBatch(ctx context.Context, url string) (cursorData, error) {
 	var data cursorData
 
-	resp, err := connection.CallPost(ctx, c.db.connection(), url, &data, nil, c.db.modifiers...)
+	resp, err := connection.CallWithEndpoint(ctx, c.db.connection(), c.endpoint, http.MethodPost, url, &data, c.db.modifiers...)
 	if err != nil {
-		return err
+		return cursorData{}, err
 	}
 
 	switch code := resp.Code(); code {
 	case http.StatusOK:
-		c.data = data
-		return nil
+		return data, nil
 	default:
-		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+		return cursorData{}, shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
+
+// enablePrefetch enables the prefetching and starts fetching the second batch.
+func (c *cursor) enablePrefetch(ctx context.Context) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.prefetchEnabled = true
+	c.startPrefetch(ctx)
+}
+
+// startPrefetch starts fetching the next batch in the background, if the prefetching is enabled and the cursor has more batches.
+// The request is not cancelled with the given context, because it can end before the batch is read,
+// but it keeps the values of the context, e.g. the logger. The request is cancelled when the cursor is closed.
+func (c *cursor) startPrefetch(ctx context.Context) {
+	if !c.prefetchEnabled || c.prefetch != nil || c.closed || !c.data.HasMore {
+		return
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	url, batchID := c.nextBatchURL()
+	prefetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+	p := &cursorPrefetch{
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		batchID: batchID,
+	}
+
+	go func() {
+		defer close(p.done)
+
+		p.data, p.err = c.fetchBatch(prefetchCtx, url)
+	}()
+
+	c.prefetch = p
+}
+
+// takePrefetch waits for the prefetched batch and makes it the current batch.
+// If the given context is done before the batch has been received, the prefetch is kept for the next call.
+func (c *cursor) takePrefetch(ctx context.Context) error {
+	p := c.prefetch
+
+	if ctx != nil {
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	} else {
+		<-p.done
+	}
+
+	c.prefetch = nil
+	p.cancel()
+
+	// Update currentBatchID as it is done before fetching the next batch without the prefetch,
+	// so the failed batch can be retried with RetryReadBatch.
+	if p.batchID != "" && c.retryData != nil {
+		c.retryData.currentBatchID = p.batchID
+	}
+
+	if p.err != nil {
+		return p.err
+	}
+
+	c.data = p.data
+	c.startPrefetch(ctx)
+	return nil
+}
+
+// stopPrefetch cancels the prefetch and waits until its request ends.
+func (c *cursor) stopPrefetch() {
+	if p := c.prefetch; p != nil {
+		c.prefetch = nil
+		p.cancel()
+		<-p.done
 	}
 }
 
@@ -210,3 +353,7 @@ func (c *cursor) Statistics() CursorStats {
 func (c *cursor) Plan() CursorPlan {
 	return c.data.Extra.Plan
 }
+
+func (c *cursor) Cached() bool {
+	return c.data.Cached
+}
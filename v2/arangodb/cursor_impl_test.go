@@ -0,0 +1,243 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// cursorTestServer serves the cursor with three batches: [1,2], [3,4] and [5].
+type cursorTestServer struct {
+	testRequestLog
+
+	received chan string
+	// block is closed to release the request of the last batch.
+	block chan struct{}
+}
+
+func newCursorTestServer(block chan struct{}) *cursorTestServer {
+	return &cursorTestServer{
+		received: make(chan string, 10),
+		block:    block,
+	}
+}
+
+func (s *cursorTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := s.add(r)
+	s.received <- request
+
+	w.Header().Set("Content-Type", "application/json")
+	switch request {
+	case "POST /_db/db/_api/cursor":
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"c1","result":[1,2],"hasMore":true,"nextBatchId":"2"}`))
+	case "POST /_db/db/_api/cursor/c1/2":
+		_, _ = w.Write([]byte(`{"id":"c1","result":[3,4],"hasMore":true,"nextBatchId":"3"}`))
+	case "POST /_db/db/_api/cursor/c1/3":
+		if s.block != nil {
+			select {
+			case <-s.block:
+			case <-r.Context().Done():
+				return
+			}
+		}
+		_, _ = w.Write([]byte(`{"id":"c1","result":[5],"hasMore":false}`))
+	case "DELETE /_db/db/_api/cursor/c1":
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":"c1"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *cursorTestServer) waitFor(t *testing.T, request string) {
+	for {
+		select {
+		case r := <-s.received:
+			if r == request {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("request %s has not been received", request)
+		}
+	}
+}
+
+func TestCursorPrefetch(t *testing.T) {
+	s := newCursorTestServer(nil)
+	db := newTestDatabase(t, s)
+	ctx := context.Background()
+
+	c, err := db.Query(ctx, "FOR i IN 1..5 RETURN i", &QueryOptions{Prefetch: true, Options: QuerySubOptions{AllowRetry: true}})
+	require.NoError(t, err)
+
+	// The second batch is requested before the first document is read.
+	s.waitFor(t, "POST /_db/db/_api/cursor/c1/2")
+
+	var values []int
+	for {
+		var v int
+		_, err := c.ReadDocument(ctx, &v)
+		if err != nil {
+			require.True(t, shared.IsNoMoreDocuments(err), err)
+			break
+		}
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, values)
+	assert.Equal(t, 1, s.count("POST /_db/db/_api/cursor/c1/2"))
+	assert.Equal(t, 1, s.count("POST /_db/db/_api/cursor/c1/3"))
+	require.NoError(t, c.Close())
+}
+
+func TestCursorPrefetchRetryReadBatch(t *testing.T) {
+	s := newCursorTestServer(make(chan struct{}))
+	db := newTestDatabase(t, s)
+	ctx := context.Background()
+
+	var first []int
+	c, err := db.QueryBatch(ctx, "FOR i IN 1..5 RETURN i", &QueryOptions{Prefetch: true, Options: QuerySubOptions{AllowRetry: true}}, &first)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, first)
+
+	var batch []int
+	require.NoError(t, c.ReadNextBatch(ctx, &batch))
+	assert.Equal(t, []int{3, 4}, batch)
+	s.waitFor(t, "POST /_db/db/_api/cursor/c1/3")
+
+	// The third batch has been already requested, so the second batch is returned from memory.
+	var retried []int
+	require.NoError(t, c.RetryReadBatch(ctx, &retried))
+	assert.Equal(t, []int{3, 4}, retried)
+	assert.Equal(t, 1, s.count("POST /_db/db/_api/cursor/c1/2"))
+
+	close(s.block)
+
+	var last []int
+	require.NoError(t, c.ReadNextBatch(ctx, &last))
+	assert.Equal(t, []int{5}, last)
+	assert.False(t, c.HasMoreBatches())
+
+	// There is no prefetch for the last batch, so it is requested again from the server.
+	require.NoError(t, c.RetryReadBatch(ctx, &retried))
+	assert.Equal(t, []int{5}, retried)
+	assert.Equal(t, 2, s.count("POST /_db/db/_api/cursor/c1/3"))
+}
+
+func TestCursorPrefetchClose(t *testing.T) {
+	s := newCursorTestServer(make(chan struct{}))
+	db := newTestDatabase(t, s)
+	ctx := context.Background()
+
+	var batch []int
+	c, err := db.QueryBatch(ctx, "FOR i IN 1..5 RETURN i", &QueryOptions{Prefetch: true, Options: QuerySubOptions{AllowRetry: true}}, &batch)
+	require.NoError(t, err)
+	require.NoError(t, c.ReadNextBatch(ctx, &batch))
+	s.waitFor(t, "POST /_db/db/_api/cursor/c1/3")
+
+	// The blocked prefetch is cancelled by Close.
+	require.NoError(t, c.Close())
+	assert.Equal(t, 1, s.count("DELETE /_db/db/_api/cursor/c1"))
+	require.True(t, shared.IsNoMoreDocuments(c.ReadNextBatch(ctx, &batch)))
+}
+
+func TestCursorPrefetchContextDone(t *testing.T) {
+	s := newCursorTestServer(make(chan struct{}))
+	db := newTestDatabase(t, s)
+
+	var batch []int
+	c, err := db.QueryBatch(context.Background(), "FOR i IN 1..5 RETURN i", &QueryOptions{Prefetch: true, Options: QuerySubOptions{AllowRetry: true}}, &batch)
+	require.NoError(t, err)
+	require.NoError(t, c.ReadNextBatch(context.Background(), &batch))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, c.ReadNextBatch(ctx, &batch), context.DeadlineExceeded)
+
+	// The prefetch is kept, so the batch is not requested again.
+	close(s.block)
+	require.NoError(t, c.ReadNextBatch(context.Background(), &batch))
+	assert.Equal(t, []int{5}, batch)
+	assert.Equal(t, 1, s.count("POST /_db/db/_api/cursor/c1/3"))
+	require.NoError(t, c.Close())
+}
+
+func TestCursorHedgedPinned(t *testing.T) {
+	s := newCursorTestServer(nil)
+	holder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The next batches are slow, so they would be hedged to the other coordinator.
+		if strings.HasPrefix(r.URL.Path, "/_db/db/_api/cursor/") {
+			time.Sleep(20 * time.Millisecond)
+		}
+		s.ServeHTTP(w, r)
+	}))
+	t.Cleanup(holder.Close)
+
+	// The other coordinator does not know the cursor, and it never answers the query.
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/db/_api/cursor" {
+			// The server notices the cancelled request only after the body has been read.
+			_, _ = io.Copy(io.Discard, r.Body)
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(other.Close)
+
+	conn := connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:    connection.NewRoundRobinEndpoints([]string{holder.URL, other.URL}),
+		ContentType: connection.ApplicationJSON,
+	})
+	conn = connection.NewHedgingWrapper(connection.HedgingOptions{Delay: time.Millisecond})(conn)
+	db := newDatabase(newClient(conn), "db")
+	ctx := connection.WithHedgeable(context.Background())
+
+	c, err := db.Query(ctx, "FOR i IN 1..5 RETURN i", nil)
+	require.NoError(t, err)
+
+	var values []int
+	for {
+		var v int
+		_, err := c.ReadDocument(ctx, &v)
+		if err != nil {
+			require.True(t, shared.IsNoMoreDocuments(err), err)
+			break
+		}
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, values)
+	require.NoError(t, c.Close())
+}
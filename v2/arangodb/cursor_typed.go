@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// TypedCursor is the Cursor which reads the documents of type T.
+// Note that a TypedCursor must always be closed to avoid holding on to resources in the server while they are no longer needed.
+type TypedCursor[T any] interface {
+	io.Closer
+
+	// CloseWithContext run Close with specified Context
+	CloseWithContext(ctx context.Context) error
+
+	// HasMore returns true if the next call to Read does not return a NoMoreDocuments error.
+	HasMore() bool
+
+	// Read reads the next document from the cursor.
+	// If the cursor has no more documents, a NoMoreDocuments error is returned.
+	// Note: If the query does not return documents, then the returned DocumentMeta will be empty.
+	Read(ctx context.Context) (T, DocumentMeta, error)
+
+	// ReadAll reads all remaining documents from the cursor.
+	ReadAll(ctx context.Context) ([]T, error)
+
+	// All returns the iterator over the remaining documents of the cursor, e.g.
+	//
+	//	for doc, err := range cursor.All(ctx) {
+	//
+	// The iteration stops after the first error. The cursor is closed when the iteration ends, also when the loop exits early.
+	All(ctx context.Context) iter.Seq2[T, error]
+
+	// Count returns the total number of result documents available.
+	// A valid return value is only available when the cursor has been created with `Count` and not with `Stream`.
+	Count() int64
+
+	// Statistics returns the query execution statistics for this cursor.
+	// This might not be valid if the cursor has been created with `Stream`
+	Statistics() CursorStats
+
+	// Plan returns the query execution plan for this cursor.
+	Plan() CursorPlan
+
+	// Cached returns true if the result of the query was served from the query results cache.
+	Cached() bool
+
+	// Cursor returns the underlying untyped cursor.
+	Cursor() Cursor
+}
+
+// Query performs the AQL query in the database and returns the cursor which reads the documents of type T.
+func Query[T any](ctx context.Context, db Database, query string, opts *QueryOptions) (TypedCursor[T], error) {
+	c, err := db.Query(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTypedCursor[T](c), nil
+}
+
+// NewTypedCursor returns the cursor which reads the documents of type T from the given cursor.
+func NewTypedCursor[T any](c Cursor) TypedCursor[T] {
+	return &typedCursor[T]{cursor: c}
+}
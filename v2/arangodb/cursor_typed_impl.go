@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"iter"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+var _ TypedCursor[any] = &typedCursor[any]{}
+
+type typedCursor[T any] struct {
+	cursor Cursor
+}
+
+func (t *typedCursor[T]) Close() error {
+	return t.cursor.Close()
+}
+
+func (t *typedCursor[T]) CloseWithContext(ctx context.Context) error {
+	return t.cursor.CloseWithContext(ctx)
+}
+
+func (t *typedCursor[T]) HasMore() bool {
+	return t.cursor.HasMore()
+}
+
+func (t *typedCursor[T]) Read(ctx context.Context) (T, DocumentMeta, error) {
+	var document T
+
+	meta, err := t.cursor.ReadDocument(ctx, &document)
+	if err != nil {
+		var zero T
+		return zero, DocumentMeta{}, err
+	}
+
+	return document, meta, nil
+}
+
+func (t *typedCursor[T]) ReadAll(ctx context.Context) ([]T, error) {
+	var documents []T
+
+	for {
+		document, _, err := t.Read(ctx)
+		if shared.IsNoMoreDocuments(err) {
+			return documents, nil
+		}
+		if err != nil {
+			return documents, err
+		}
+
+		documents = append(documents, document)
+	}
+}
+
+func (t *typedCursor[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return CursorSeq[T](ctx, t.cursor)
+}
+
+func (t *typedCursor[T]) Count() int64 {
+	return t.cursor.Count()
+}
+
+func (t *typedCursor[T]) Statistics() CursorStats {
+	return t.cursor.Statistics()
+}
+
+func (t *typedCursor[T]) Plan() CursorPlan {
+	return t.cursor.Plan()
+}
+
+func (t *typedCursor[T]) Cached() bool {
+	return t.cursor.Cached()
+}
+
+func (t *typedCursor[T]) Cursor() Cursor {
+	return t.cursor
+}
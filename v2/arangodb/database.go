@@ -41,7 +41,12 @@ type Database interface {
 	DatabaseCollection
 	DatabaseTransaction
 	DatabaseQuery
+	DatabaseQueryCache
+	DatabaseAQLFunctions
 	DatabaseView
 	DatabaseAnalyzer
 	DatabaseGraph
+	DatabaseFoxx
+	DatabasePregel
+	DatabaseExport
 }
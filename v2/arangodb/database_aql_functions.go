@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// DatabaseAQLFunctions provides access to the user-defined AQL functions of the database.
+type DatabaseAQLFunctions interface {
+	// CreateAQLFunction registers a user-defined AQL function.
+	// If a function with the same name already exists, it is replaced.
+	// It returns true if the function has been newly created, and false if it has been replaced.
+	CreateAQLFunction(ctx context.Context, function AQLFunction) (bool, error)
+
+	// ListAQLFunctions returns the user-defined AQL functions.
+	// If the namespace is not empty, then only the functions within the namespace are returned, e.g. "myfunctions::".
+	ListAQLFunctions(ctx context.Context, namespace string) ([]AQLFunction, error)
+
+	// DeleteAQLFunction removes the user-defined AQL function with the given name.
+	// If group is set to true, then the name is treated as a namespace, and all functions within it are removed.
+	// It returns the number of removed functions.
+	DeleteAQLFunction(ctx context.Context, name string, group bool) (int, error)
+}
+
+// AQLFunction is a user-defined AQL function.
+type AQLFunction struct {
+	// Name is the fully qualified name of the function, including the namespace, e.g. "myfunctions::temperature::celsiustofahrenheit".
+	Name string `json:"name"`
+
+	// Code is a string representation of the JavaScript function body.
+	Code string `json:"code"`
+
+	// IsDeterministic states whether the function results are fully deterministic,
+	// i.e. the function always returns the same result for the same input.
+	// It allows the query optimizer to apply further optimizations.
+	IsDeterministic bool `json:"isDeterministic,omitempty"`
+}
@@ -0,0 +1,121 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseAQLFunctions(db *database) *databaseAQLFunctions {
+	return &databaseAQLFunctions{
+		db: db,
+	}
+}
+
+var _ DatabaseAQLFunctions = &databaseAQLFunctions{}
+
+type databaseAQLFunctions struct {
+	db *database
+}
+
+func (d databaseAQLFunctions) CreateAQLFunction(ctx context.Context, function AQLFunction) (bool, error) {
+	if function.Name == "" || function.Code == "" {
+		return false, errors.WithStack(shared.InvalidArgumentError{Message: "name and code of the function must be set"})
+	}
+
+	urlEndpoint := d.db.url("_api", "aqlfunction")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		IsNewlyCreated        bool `json:"isNewlyCreated,omitempty"`
+	}
+
+	resp, err := connection.CallPost(ctx, d.db.connection(), urlEndpoint, &response, &function, d.db.modifiers...)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusCreated:
+		return response.IsNewlyCreated, nil
+	default:
+		return false, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseAQLFunctions) ListAQLFunctions(ctx context.Context, namespace string) ([]AQLFunction, error) {
+	urlEndpoint := d.db.url("_api", "aqlfunction")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                []AQLFunction `json:"result,omitempty"`
+	}
+
+	mods := d.db.modifiers
+	if namespace != "" {
+		mods = d.db.withModifiers(connection.WithQuery("namespace", namespace))
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response, mods...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseAQLFunctions) DeleteAQLFunction(ctx context.Context, name string, group bool) (int, error) {
+	if name == "" {
+		return 0, errors.WithStack(shared.InvalidArgumentError{Message: "name of the function must be set"})
+	}
+
+	urlEndpoint := d.db.url("_api", "aqlfunction", url.PathEscape(name))
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		DeletedCount          int `json:"deletedCount,omitempty"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), urlEndpoint, &response,
+		d.db.withModifiers(connection.WithQuery("group", boolToString(group)))...)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.DeletedCount, nil
+	default:
+		return 0, response.AsArangoErrorWithCode(code)
+	}
+}
@@ -0,0 +1,121 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+)
+
+// DatabaseExport provides the export of the query results and the collections to files.
+type DatabaseExport interface {
+	// ExportQuery runs the AQL query with a streaming cursor and writes its results to the given writer
+	// in the format given by ExportOptions.Format. The documents are read as raw JSON, so they are not decoded into Go values.
+	// The results are written batch by batch, a batch is written only when it has been received entirely.
+	// If the export fails, the returned progress can be passed to ExportOptions.Resume to continue the export
+	// from the first byte which has not been written, as long as the cursor exists on the server.
+	ExportQuery(ctx context.Context, query string, opts *ExportOptions, w io.Writer) (ExportProgress, error)
+
+	// ExportCollection writes all documents of the collection to the given writer. It works like ExportQuery.
+	ExportCollection(ctx context.Context, collection string, opts *ExportOptions, w io.Writer) (ExportProgress, error)
+}
+
+// ExportFormat is the format of the exported documents.
+type ExportFormat string
+
+const (
+	// ExportFormatNDJSON writes each document as JSON in a separate line.
+	ExportFormatNDJSON ExportFormat = "ndjson"
+	// ExportFormatCSV writes each document as a CSV record with the columns given by ExportOptions.Columns.
+	ExportFormatCSV ExportFormat = "csv"
+)
+
+// ExportColumn maps an attribute of the document to the CSV column.
+type ExportColumn struct {
+	// Name is the header of the column.
+	Name string
+	// Path is the path of the attribute in the document, e.g. []string{"address", "city"}.
+	// If it is empty, the attribute with the name of the column is used.
+	Path []string
+}
+
+// ExportOptions contains options for the export of the query results and the collections.
+type ExportOptions struct {
+	// Format is the format of the exported documents. ExportFormatNDJSON is used if it is empty.
+	Format ExportFormat
+
+	// Columns are the columns of the CSV records. They are required for ExportFormatCSV.
+	// Strings are written without quotes, null and missing attributes as empty values,
+	// other values (numbers, booleans, objects and arrays) as JSON.
+	Columns []ExportColumn
+
+	// Comma is the field delimiter of the CSV records. It is ',' if it is not set.
+	Comma rune
+
+	// SkipHeader disables writing of the CSV header with the names of the columns.
+	SkipHeader bool
+
+	// Query contains the options of the query, e.g. the bind parameters or the batch size.
+	// The query always uses a streaming cursor which allows retries (QuerySubOptions.Stream and QuerySubOptions.AllowRetry),
+	// and its batches are not prefetched (QueryOptions.Prefetch).
+	Query *QueryOptions
+
+	// Progress is called after each batch has been written.
+	Progress func(progress ExportProgress)
+
+	// Resume is the progress returned by the failed export. If it is set, the export continues with the cursor
+	// of the failed export, and the documents which have been already written are not written again.
+	// The batch which has been written partially is requested again, and only its remaining bytes are written,
+	// so the export must be resumed with the same Format, Columns, Comma and SkipHeader options.
+	// If the result of the query has a single batch, the cursor is not kept on the server, so the query is run again.
+	Resume *ExportProgress
+}
+
+// ExportProgress describes the progress of the export.
+type ExportProgress struct {
+	// CursorID is the ID of the cursor on the server. It is set as soon as the first batch has been received.
+	CursorID string `json:"cursorId,omitempty"`
+
+	// Endpoint is the endpoint which has created the cursor. The batches of the cursor are requested from it.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// BatchID is the ID of the last batch which has been written.
+	BatchID string `json:"batchId,omitempty"`
+
+	// NextBatchID is the ID of the batch which is written next.
+	NextBatchID string `json:"nextBatchId,omitempty"`
+
+	// PartialBytes is the number of the bytes of the next batch which have been written before the export failed.
+	// They are not written again when the export is resumed.
+	PartialBytes int64 `json:"partialBytes,omitempty"`
+
+	// Batches is the number of the batches which have been written.
+	Batches int `json:"batches"`
+
+	// Documents is the number of the documents which have been written.
+	Documents int64 `json:"documents"`
+
+	// Bytes is the number of the bytes which have been written, including PartialBytes.
+	Bytes int64 `json:"bytes"`
+
+	// Done is true if all documents have been written.
+	Done bool `json:"done"`
+}
@@ -0,0 +1,344 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func newDatabaseExport(db *database) *databaseExport {
+	return &databaseExport{
+		db: db,
+	}
+}
+
+var _ DatabaseExport = &databaseExport{}
+
+type databaseExport struct {
+	db *database
+}
+
+func (d databaseExport) ExportQuery(ctx context.Context, query string, opts *ExportOptions, w io.Writer) (ExportProgress, error) {
+	e, err := d.newExporter(opts, w)
+	if err != nil {
+		return ExportProgress{}, err
+	}
+
+	return e.run(ctx, query)
+}
+
+func (d databaseExport) ExportCollection(ctx context.Context, collection string, opts *ExportOptions, w io.Writer) (ExportProgress, error) {
+	e, err := d.newExporter(opts, w)
+	if err != nil {
+		return ExportProgress{}, err
+	}
+
+	bindVars := make(map[string]interface{}, len(e.query.BindVars)+1)
+	for k, v := range e.query.BindVars {
+		bindVars[k] = v
+	}
+	bindVars["@collection"] = collection
+	e.query.BindVars = bindVars
+
+	return e.run(ctx, "FOR d IN @@collection RETURN d")
+}
+
+func (d databaseExport) newExporter(opts *ExportOptions, w io.Writer) (*exporter, error) {
+	e := &exporter{
+		db: d.db,
+		w:  w,
+	}
+
+	if opts != nil {
+		e.opts = *opts
+	}
+
+	switch e.opts.Format {
+	case "":
+		e.opts.Format = ExportFormatNDJSON
+	case ExportFormatNDJSON:
+	case ExportFormatCSV:
+		if len(e.opts.Columns) == 0 {
+			return nil, errors.WithStack(shared.InvalidArgumentError{Message: "columns must be set for the CSV format"})
+		}
+	default:
+		return nil, errors.WithStack(shared.InvalidArgumentError{Message: fmt.Sprintf("unknown export format %q", e.opts.Format)})
+	}
+
+	if e.opts.Comma == 0 {
+		e.opts.Comma = ','
+	}
+
+	if e.opts.Query != nil {
+		e.query = *e.opts.Query
+	}
+	e.query.Options.Stream = true
+	e.query.Options.AllowRetry = true
+	// The server keeps only the last batch, so the prefetched batch would not let the failed batch be requested again.
+	e.query.Prefetch = false
+
+	if e.opts.Resume != nil {
+		e.progress = *e.opts.Resume
+	}
+
+	return e, nil
+}
+
+// exporter writes the batches of the streaming cursor to the writer.
+type exporter struct {
+	db       *database
+	w        io.Writer
+	opts     ExportOptions
+	query    QueryOptions
+	progress ExportProgress
+}
+
+func (e *exporter) run(ctx context.Context, query string) (ExportProgress, error) {
+	if e.progress.Done {
+		return e.progress, nil
+	}
+
+	c, err := e.cursor(ctx, query)
+	if err != nil {
+		return e.progress, err
+	}
+
+	for {
+		// The cursor allows retries, so the batch can be requested again if it has not been written.
+		if c.retryData != nil {
+			e.progress.CursorID = c.data.ID
+			e.progress.Endpoint = c.endpoint
+			e.progress.NextBatchID = c.retryData.currentBatchID
+		}
+
+		data, documents, err := e.format(c.data.Result.in)
+		if err != nil {
+			return e.progress, err
+		}
+
+		if err := e.write(data); err != nil {
+			return e.progress, err
+		}
+
+		e.progress.BatchID = e.progress.NextBatchID
+		e.progress.NextBatchID = c.data.NextBatchID
+		e.progress.Batches++
+		e.progress.Documents += documents
+		e.progress.Done = !c.data.HasMore
+
+		if e.opts.Progress != nil {
+			e.opts.Progress(e.progress)
+		}
+
+		if e.progress.Done {
+			return e.progress, nil
+		}
+
+		if err := e.next(ctx, c); err != nil {
+			return e.progress, err
+		}
+	}
+}
+
+// cursor creates the cursor of the query, or it returns the cursor of the resumed export with its next batch.
+// The batches of the cursor are requested from the endpoint which has created it.
+func (e *exporter) cursor(ctx context.Context, query string) (*cursor, error) {
+	if e.progress.CursorID == "" {
+		return databaseQuery{db: e.db}.getCursor(ctx, query, &e.query, nil)
+	}
+
+	c := newCursor(e.db, e.progress.Endpoint, cursorData{
+		ID:          e.progress.CursorID,
+		NextBatchID: e.progress.NextBatchID,
+		HasMore:     true,
+	})
+
+	if err := e.next(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// next requests the next batch of the cursor.
+func (e *exporter) next(ctx context.Context, c *cursor) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.getNextBatch(ctx, "")
+}
+
+// write writes the batch without the part which has been already written by the failed export.
+func (e *exporter) write(data []byte) error {
+	if e.progress.PartialBytes > int64(len(data)) {
+		return errors.Errorf("the batch has %d bytes, but %d bytes have been already written", len(data), e.progress.PartialBytes)
+	}
+
+	n, err := e.w.Write(data[e.progress.PartialBytes:])
+	e.progress.Bytes += int64(n)
+	e.progress.PartialBytes += int64(n)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	e.progress.PartialBytes = 0
+	return nil
+}
+
+// format formats the documents of the batch, which is the raw JSON array of the cursor result.
+// It returns the formatted batch and the number of its documents.
+func (e *exporter) format(result []byte) ([]byte, int64, error) {
+	var data bytes.Buffer
+
+	var csvWriter *csv.Writer
+	if e.opts.Format == ExportFormatCSV {
+		csvWriter = csv.NewWriter(&data)
+		csvWriter.Comma = e.opts.Comma
+
+		if e.progress.Batches == 0 && !e.opts.SkipHeader {
+			header := make([]string, len(e.opts.Columns))
+			for i, column := range e.opts.Columns {
+				header[i] = column.Name
+			}
+
+			if err := csvWriter.Write(header); err != nil {
+				return nil, 0, errors.WithStack(err)
+			}
+		}
+	}
+
+	var documents int64
+	if len(bytes.TrimSpace(result)) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(result))
+		if err := expectJSONDelim(decoder, '['); err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+
+		for decoder.More() {
+			var document json.RawMessage
+			if err := decoder.Decode(&document); err != nil {
+				return nil, 0, errors.WithStack(err)
+			}
+
+			var err error
+			if csvWriter != nil {
+				err = csvWriter.Write(e.csvRecord(document))
+			} else {
+				err = json.Compact(&data, document)
+				data.WriteByte('\n')
+			}
+			if err != nil {
+				return nil, 0, errors.WithStack(err)
+			}
+
+			documents++
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+	}
+
+	return data.Bytes(), documents, nil
+}
+
+// csvRecord returns the values of the columns of the document.
+func (e *exporter) csvRecord(document json.RawMessage) []string {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(document, &object); err != nil {
+		// The document is not an object, so it does not have attributes.
+		object = nil
+	}
+
+	record := make([]string, len(e.opts.Columns))
+	for i, column := range e.opts.Columns {
+		path := column.Path
+		if len(path) == 0 {
+			path = []string{column.Name}
+		}
+
+		value, ok := object[path[0]]
+		for _, name := range path[1:] {
+			if !ok {
+				break
+			}
+
+			var nested map[string]json.RawMessage
+			if err := json.Unmarshal(value, &nested); err != nil {
+				ok = false
+				break
+			}
+			value, ok = nested[name]
+		}
+
+		if ok {
+			record[i] = csvValue(value)
+		}
+	}
+
+	return record
+}
+
+// csvValue returns strings without quotes, null as an empty value and other values as compact JSON.
+func csvValue(value json.RawMessage) string {
+	value = bytes.TrimSpace(value)
+
+	switch {
+	case len(value) == 0 || bytes.Equal(value, []byte("null")):
+		return ""
+	case value[0] == '"':
+		var s string
+		if err := json.Unmarshal(value, &s); err == nil {
+			return s
+		}
+	}
+
+	var b bytes.Buffer
+	if err := json.Compact(&b, value); err != nil {
+		return string(value)
+	}
+	return b.String()
+}
+
+func expectJSONDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return errors.Errorf("unexpected token %v, expected %v", token, delim)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// exportTestServer serves the streaming cursor with the given batches.
+type exportTestServer struct {
+	testRequestLog
+
+	batches []string
+
+	lock  sync.Mutex
+	query map[string]interface{}
+}
+
+func (s *exportTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := s.add(r)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	index := -1
+	switch request {
+	case "POST /_db/db/_api/cursor":
+		var query map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&query)
+		s.lock.Lock()
+		s.query = query
+		s.lock.Unlock()
+
+		w.WriteHeader(http.StatusCreated)
+		index = 0
+	case "POST /_db/db/_api/cursor/c1/1":
+		index = 0
+	case "POST /_db/db/_api/cursor/c1/2":
+		index = 1
+	case "POST /_db/db/_api/cursor/c1/3":
+		index = 2
+	}
+
+	if index < 0 || index >= len(s.batches) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":true,"code":404,"errorNum":1600,"errorMessage":"cursor not found"}`))
+		return
+	}
+
+	_, _ = w.Write([]byte(s.batches[index]))
+}
+
+var exportTestBatches = []string{
+	`{"result":[{"_key":"1","name":"a","address":{"city":"Cologne"}},{"_key":"2","name":"b,c","age":3}],"hasMore":true,"id":"c1","nextBatchId":"2","code":201}`,
+	`{"result":[ {"_key":"3", "name":null, "tags":["x", "y"]} ],"hasMore":true,"id":"c1","nextBatchId":"3","code":200}`,
+	`{"result":[{"_key":"4"}],"hasMore":false,"id":"c1","code":200}`,
+}
+
+func TestExportQueryNDJSON(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches}
+	db := newTestDatabase(t, s)
+	endpoint := db.connection().GetEndpoint().List()[0]
+
+	var progress []ExportProgress
+	var out bytes.Buffer
+	result, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", &ExportOptions{
+		Query: &QueryOptions{BatchSize: 2},
+		Progress: func(p ExportProgress) {
+			progress = append(progress, p)
+		},
+	}, &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"_key":"1","name":"a","address":{"city":"Cologne"}}
+{"_key":"2","name":"b,c","age":3}
+{"_key":"3","name":null,"tags":["x","y"]}
+{"_key":"4"}
+`, out.String())
+
+	require.Len(t, progress, 3)
+	assert.Equal(t, ExportProgress{CursorID: "c1", Endpoint: endpoint, BatchID: "1", NextBatchID: "2", Batches: 1, Documents: 2, Bytes: progress[0].Bytes}, progress[0])
+	assert.Equal(t, ExportProgress{CursorID: "c1", Endpoint: endpoint, BatchID: "2", NextBatchID: "3", Batches: 2, Documents: 3, Bytes: progress[1].Bytes}, progress[1])
+	assert.Equal(t, ExportProgress{CursorID: "c1", Endpoint: endpoint, BatchID: "3", Batches: 3, Documents: 4, Bytes: int64(out.Len()), Done: true}, result)
+	assert.Equal(t, result, progress[2])
+
+	assert.Equal(t, "FOR d IN col RETURN d", s.query["query"])
+	assert.EqualValues(t, 2, s.query["batchSize"])
+	require.IsType(t, map[string]interface{}{}, s.query["options"])
+	assert.Equal(t, true, s.query["options"].(map[string]interface{})["stream"])
+	assert.Equal(t, true, s.query["options"].(map[string]interface{})["allowRetry"])
+}
+
+func TestExportCollectionCSV(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches}
+	db := newTestDatabase(t, s)
+
+	var out bytes.Buffer
+	result, err := db.ExportCollection(context.Background(), "col", &ExportOptions{
+		Format: ExportFormatCSV,
+		Columns: []ExportColumn{
+			{Name: "_key"},
+			{Name: "name"},
+			{Name: "city", Path: []string{"address", "city"}},
+			{Name: "age"},
+			{Name: "tags"},
+		},
+	}, &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, `_key,name,city,age,tags
+1,a,Cologne,,
+2,"b,c",,3,
+3,,,,"[""x"",""y""]"
+4,,,,
+`, out.String())
+	assert.EqualValues(t, 4, result.Documents)
+	assert.True(t, result.Done)
+
+	assert.Equal(t, "FOR d IN @@collection RETURN d", s.query["query"])
+	assert.Equal(t, map[string]interface{}{"@collection": "col"}, s.query["bindVars"])
+}
+
+// failingWriter fails after the given number of writes. The failing write writes the first partial bytes.
+type failingWriter struct {
+	bytes.Buffer
+	writes  int
+	partial int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.writes == 0 {
+		n, _ := w.Buffer.Write(p[:w.partial])
+		return n, errors.New("disk full")
+	}
+	w.writes--
+
+	return w.Buffer.Write(p)
+}
+
+func TestExportQueryResume(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches}
+	db := newTestDatabase(t, s)
+	endpoint := db.connection().GetEndpoint().List()[0]
+
+	opts := &ExportOptions{
+		Format:  ExportFormatCSV,
+		Columns: []ExportColumn{{Name: "_key"}},
+	}
+
+	out := &failingWriter{writes: 1}
+	progress, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", opts, out)
+	require.Error(t, err)
+	assert.Equal(t, ExportProgress{CursorID: "c1", Endpoint: endpoint, BatchID: "1", NextBatchID: "2", Batches: 1, Documents: 2, Bytes: int64(out.Len())}, progress)
+
+	// The second batch is requested again, and the header is not written again.
+	opts.Resume = &progress
+	result, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", opts, &out.Buffer)
+	require.NoError(t, err)
+
+	assert.Equal(t, "_key\n1\n2\n3\n4\n", out.String())
+	assert.Equal(t, ExportProgress{CursorID: "c1", Endpoint: endpoint, BatchID: "3", Batches: 3, Documents: 4, Bytes: int64(out.Len()), Done: true}, result)
+	assert.Equal(t, []string{
+		"POST /_db/db/_api/cursor",
+		"POST /_db/db/_api/cursor/c1/2",
+		"POST /_db/db/_api/cursor/c1/2",
+		"POST /_db/db/_api/cursor/c1/3",
+	}, s.all())
+
+	// The finished export is not continued.
+	result, err = db.ExportQuery(context.Background(), "FOR d IN col RETURN d", &ExportOptions{Resume: &result}, io.Discard)
+	require.NoError(t, err)
+	assert.True(t, result.Done)
+	assert.Len(t, s.all(), 4)
+}
+
+func TestExportQueryResumeEndpoint(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches}
+	db := newTestDatabase(t, s)
+	endpoint := db.connection().GetEndpoint().List()[0]
+
+	out := &failingWriter{writes: 1}
+	progress, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", nil, out)
+	require.Error(t, err)
+
+	// The other coordinator does not know the cursor.
+	other := httptest.NewServer(&exportTestServer{})
+	t.Cleanup(other.Close)
+
+	conn := connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:    connection.NewRoundRobinEndpoints([]string{other.URL, endpoint}),
+		ContentType: connection.ApplicationJSON,
+	})
+	resumed := newDatabase(newClient(conn), "db")
+
+	// The batches are requested from the coordinator which has created the cursor.
+	result, err := resumed.ExportQuery(context.Background(), "FOR d IN col RETURN d", &ExportOptions{Resume: &progress}, &out.Buffer)
+	require.NoError(t, err)
+	assert.True(t, result.Done)
+	assert.Equal(t, int64(4), result.Documents)
+}
+
+func TestExportQueryResumePartialWrite(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches}
+	db := newTestDatabase(t, s)
+
+	out := &failingWriter{writes: 1, partial: 10}
+	progress, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", nil, out)
+	require.Error(t, err)
+	assert.Equal(t, "2", progress.NextBatchID)
+	assert.EqualValues(t, 10, progress.PartialBytes)
+	assert.Equal(t, int64(out.Len()), progress.Bytes)
+
+	// Only the remaining part of the second batch is written.
+	result, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", &ExportOptions{Resume: &progress}, &out.Buffer)
+	require.NoError(t, err)
+	assert.Equal(t, `{"_key":"1","name":"a","address":{"city":"Cologne"}}
+{"_key":"2","name":"b,c","age":3}
+{"_key":"3","name":null,"tags":["x","y"]}
+{"_key":"4"}
+`, out.String())
+	assert.Zero(t, result.PartialBytes)
+	assert.Equal(t, int64(out.Len()), result.Bytes)
+	assert.EqualValues(t, 4, result.Documents)
+}
+
+func TestExportQueryResumeFirstBatch(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches}
+	db := newTestDatabase(t, s)
+	endpoint := db.connection().GetEndpoint().List()[0]
+
+	opts := &ExportOptions{
+		Format:  ExportFormatCSV,
+		Columns: []ExportColumn{{Name: "_key"}},
+	}
+
+	out := &failingWriter{writes: 0, partial: 3}
+	progress, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", opts, out)
+	require.Error(t, err)
+	assert.Equal(t, ExportProgress{CursorID: "c1", Endpoint: endpoint, NextBatchID: "1", PartialBytes: 3, Bytes: 3}, progress)
+
+	// The first batch is requested again from the cursor, instead of running the query again.
+	opts.Resume = &progress
+	result, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", opts, &out.Buffer)
+	require.NoError(t, err)
+	assert.Equal(t, "_key\n1\n2\n3\n4\n", out.String())
+	assert.True(t, result.Done)
+	assert.Equal(t, []string{
+		"POST /_db/db/_api/cursor",
+		"POST /_db/db/_api/cursor/c1/1",
+		"POST /_db/db/_api/cursor/c1/2",
+		"POST /_db/db/_api/cursor/c1/3",
+	}, s.all())
+}
+
+func TestExportQueryErrors(t *testing.T) {
+	s := &exportTestServer{batches: exportTestBatches[:1]}
+	db := newTestDatabase(t, s)
+
+	_, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", &ExportOptions{Format: ExportFormatCSV}, io.Discard)
+	require.True(t, shared.IsInvalidArgument(err))
+
+	_, err = db.ExportQuery(context.Background(), "FOR d IN col RETURN d", &ExportOptions{Format: "xml"}, io.Discard)
+	require.True(t, shared.IsInvalidArgument(err))
+
+	// The cursor does not have the second batch, so the export fails without writing it.
+	var out bytes.Buffer
+	progress, err := db.ExportQuery(context.Background(), "FOR d IN col RETURN d", nil, &out)
+	require.True(t, shared.IsNotFound(err), err)
+	assert.Equal(t, 1, progress.Batches)
+	assert.Equal(t, int64(out.Len()), progress.Bytes)
+}
@@ -0,0 +1,418 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+type DatabaseFoxx interface {
+	// ListFoxxServices returns all Foxx services installed in the database.
+	ListFoxxServices(ctx context.Context, opts *FoxxListOptions) ([]FoxxServiceListItem, error)
+
+	// GetFoxxService returns detailed information about the service installed at the given mount path.
+	GetFoxxService(ctx context.Context, mount string) (FoxxServiceDetails, error)
+
+	// InstallFoxxService installs a new service at the given mount path.
+	InstallFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxInstallOptions) (FoxxServiceDetails, error)
+
+	// ReplaceFoxxService removes the service at the given mount path and installs the given new service in its place.
+	ReplaceFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxReplaceOptions) (FoxxServiceDetails, error)
+
+	// UpgradeFoxxService installs the given new service on top of the service currently installed at the given mount path.
+	// The configuration and the dependencies of the old service are kept.
+	UpgradeFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxUpgradeOptions) (FoxxServiceDetails, error)
+
+	// UninstallFoxxService removes the service at the given mount path.
+	UninstallFoxxService(ctx context.Context, mount string, opts *FoxxUninstallOptions) error
+
+	// GetFoxxServiceConfiguration returns the configuration options of the service at the given mount path.
+	GetFoxxServiceConfiguration(ctx context.Context, mount string) (map[string]FoxxServiceConfigurationOption, error)
+
+	// UpdateFoxxServiceConfiguration merges the given values into the current configuration of the service.
+	UpdateFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error)
+
+	// ReplaceFoxxServiceConfiguration replaces the configuration of the service with the given values.
+	// Options which are not provided are reset to their default values.
+	ReplaceFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error)
+
+	// GetFoxxServiceDependencies returns the dependencies of the service at the given mount path.
+	GetFoxxServiceDependencies(ctx context.Context, mount string) (map[string]FoxxServiceDependency, error)
+
+	// UpdateFoxxServiceDependencies merges the given mount paths into the current dependencies of the service.
+	UpdateFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error)
+
+	// ReplaceFoxxServiceDependencies replaces the dependencies of the service with the given mount paths.
+	// Dependencies which are not provided are disabled.
+	ReplaceFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error)
+
+	// EnableFoxxDevelopmentMode puts the service at the given mount path into development mode.
+	// The service is reloaded from the file system of the server on every request.
+	EnableFoxxDevelopmentMode(ctx context.Context, mount string) (FoxxServiceDetails, error)
+
+	// DisableFoxxDevelopmentMode puts the service at the given mount path into production mode.
+	DisableFoxxDevelopmentMode(ctx context.Context, mount string) (FoxxServiceDetails, error)
+
+	// ListFoxxServiceScripts returns the scripts of the service. The key is the script name, the value is its title.
+	ListFoxxServiceScripts(ctx context.Context, mount string) (map[string]string, error)
+
+	// RunFoxxServiceScript runs the script of the service with the given arguments.
+	// The script result is stored into result (if not nil).
+	RunFoxxServiceScript(ctx context.Context, mount string, name string, args interface{}, result interface{}) error
+
+	// RunFoxxServiceTests runs the tests of the service and stores the report into result.
+	// For the default reporter FoxxServiceTestsReport can be used as result.
+	RunFoxxServiceTests(ctx context.Context, mount string, opts *FoxxTestOptions, result interface{}) error
+
+	// DownloadFoxxServiceBundle returns the zip bundle of the service at the given mount path.
+	DownloadFoxxServiceBundle(ctx context.Context, mount string) ([]byte, error)
+
+	// GetFoxxServiceReadme returns the README of the service.
+	// An empty string is returned if the service does not have a README.
+	GetFoxxServiceReadme(ctx context.Context, mount string) (string, error)
+
+	// GetFoxxServiceSwagger returns the Swagger API description of the service.
+	GetFoxxServiceSwagger(ctx context.Context, mount string) (FoxxServiceSwagger, error)
+}
+
+// FoxxServiceSource describes where the service is installed from.
+// Exactly one of the fields must be set.
+type FoxxServiceSource struct {
+	// Bundle is a zip bundle of the service. It is sent as `application/zip`.
+	Bundle io.Reader
+
+	// Script is a standalone JavaScript file. It is sent as `application/javascript`.
+	Script io.Reader
+
+	// Source is a URL or a file system path on the server from which the service is installed.
+	Source string
+}
+
+// NewFoxxServiceSourceZip returns the source for the zip bundle of the service.
+func NewFoxxServiceSourceZip(bundle io.Reader) FoxxServiceSource {
+	return FoxxServiceSource{Bundle: bundle}
+}
+
+func (f FoxxServiceSource) modifyRequest(r connection.Request) error {
+	switch {
+	case f.Bundle != nil:
+		r.AddHeader(connection.ContentType, connection.ApplicationZip)
+		return r.SetBody(f.Bundle)
+	case f.Script != nil:
+		r.AddHeader(connection.ContentType, connection.ApplicationJavaScript)
+		return r.SetBody(f.Script)
+	default:
+		return r.SetBody(struct {
+			Source string `json:"source"`
+		}{
+			Source: f.Source,
+		})
+	}
+}
+
+func (f FoxxServiceSource) validate() error {
+	set := 0
+	if f.Bundle != nil {
+		set++
+	}
+	if f.Script != nil {
+		set++
+	}
+	if f.Source != "" {
+		set++
+	}
+
+	if set != 1 {
+		return shared.InvalidArgumentError{Message: "exactly one of Bundle, Script or Source must be set in FoxxServiceSource"}
+	}
+
+	return nil
+}
+
+type FoxxListOptions struct {
+	// ExcludeSystem excludes system services (mount paths starting with `/_`) from the list.
+	ExcludeSystem *bool
+}
+
+func (f *FoxxListOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.ExcludeSystem != nil {
+		r.AddQuery("excludeSystem", boolToString(*f.ExcludeSystem))
+	}
+
+	return nil
+}
+
+type FoxxInstallOptions struct {
+	// Development enables the development mode for the installed service.
+	Development *bool
+
+	// Setup runs the setup script of the service. Default: true.
+	Setup *bool
+
+	// Legacy installs the service in the 2.8 legacy compatibility mode.
+	Legacy *bool
+}
+
+func (f *FoxxInstallOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Development != nil {
+		r.AddQuery("development", boolToString(*f.Development))
+	}
+
+	if f.Setup != nil {
+		r.AddQuery("setup", boolToString(*f.Setup))
+	}
+
+	if f.Legacy != nil {
+		r.AddQuery("legacy", boolToString(*f.Legacy))
+	}
+
+	return nil
+}
+
+type FoxxReplaceOptions struct {
+	// Teardown runs the teardown script of the old service. Default: true.
+	Teardown *bool
+
+	// Setup runs the setup script of the new service. Default: true.
+	Setup *bool
+
+	// Legacy installs the service in the 2.8 legacy compatibility mode.
+	Legacy *bool
+
+	// Force installs the service even if no service is installed at the mount path.
+	Force *bool
+}
+
+func (f *FoxxReplaceOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Teardown != nil {
+		r.AddQuery("teardown", boolToString(*f.Teardown))
+	}
+
+	if f.Setup != nil {
+		r.AddQuery("setup", boolToString(*f.Setup))
+	}
+
+	if f.Legacy != nil {
+		r.AddQuery("legacy", boolToString(*f.Legacy))
+	}
+
+	if f.Force != nil {
+		r.AddQuery("force", boolToString(*f.Force))
+	}
+
+	return nil
+}
+
+// FoxxUpgradeOptions accepts the same options as the replace operation.
+// The teardown script is not run by default during an upgrade.
+type FoxxUpgradeOptions FoxxReplaceOptions
+
+func (f *FoxxUpgradeOptions) modifyRequest(r connection.Request) error {
+	return (*FoxxReplaceOptions)(f).modifyRequest(r)
+}
+
+type FoxxUninstallOptions struct {
+	// Teardown runs the teardown script of the service. Default: true.
+	Teardown *bool
+}
+
+func (f *FoxxUninstallOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Teardown != nil {
+		r.AddQuery("teardown", boolToString(*f.Teardown))
+	}
+
+	return nil
+}
+
+type FoxxTestOptions struct {
+	// Reporter is the test reporter to use: "default", "suite", "stream", "xunit" or "tap".
+	Reporter string
+
+	// Idiomatic uses the matching format for the reporter, e.g. XML for "xunit" instead of JSON.
+	Idiomatic *bool
+
+	// Filter runs only tests whose full name (including the suite names) contains the given string.
+	Filter string
+}
+
+func (f *FoxxTestOptions) modifyRequest(r connection.Request) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Reporter != "" {
+		r.AddQuery("reporter", f.Reporter)
+	}
+
+	if f.Idiomatic != nil {
+		r.AddQuery("idiomatic", boolToString(*f.Idiomatic))
+	}
+
+	if f.Filter != "" {
+		r.AddQuery("filter", f.Filter)
+	}
+
+	return nil
+}
+
+type FoxxServiceListItem struct {
+	// Mount is the mount path of the service.
+	Mount string `json:"mount"`
+
+	// Name is the name of the service (from the manifest).
+	Name string `json:"name,omitempty"`
+
+	// Version is the version of the service (from the manifest).
+	Version string `json:"version,omitempty"`
+
+	// Provides describes the service definitions the service provides.
+	Provides map[string]interface{} `json:"provides,omitempty"`
+
+	// Development is true if the service is running in development mode.
+	Development bool `json:"development"`
+
+	// Legacy is true if the service is running in the 2.8 legacy compatibility mode.
+	Legacy bool `json:"legacy"`
+}
+
+type FoxxServiceDetails struct {
+	// Mount is the mount path of the service.
+	Mount string `json:"mount"`
+
+	// Path is the local file system path of the service on the server.
+	Path string `json:"path,omitempty"`
+
+	// Name is the name of the service (from the manifest).
+	Name string `json:"name,omitempty"`
+
+	// Version is the version of the service (from the manifest).
+	Version string `json:"version,omitempty"`
+
+	// Development is true if the service is running in development mode.
+	Development bool `json:"development"`
+
+	// Legacy is true if the service is running in the 2.8 legacy compatibility mode.
+	Legacy bool `json:"legacy"`
+
+	// Manifest is the normalized content of the manifest file of the service.
+	Manifest map[string]interface{} `json:"manifest,omitempty"`
+
+	// Checksum is the checksum of the service bundle.
+	Checksum string `json:"checksum,omitempty"`
+
+	// Options contains the current values of the configuration and the dependencies.
+	Options FoxxServiceDetailsOptions `json:"options,omitempty"`
+}
+
+type FoxxServiceDetailsOptions struct {
+	Configuration map[string]interface{} `json:"configuration,omitempty"`
+	Dependencies  map[string]interface{} `json:"dependencies,omitempty"`
+}
+
+type FoxxServiceConfigurationOption struct {
+	Title       string      `json:"title,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Type        string      `json:"type,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+	Required    bool        `json:"required"`
+
+	// Current is the current value of the option.
+	Current interface{} `json:"current,omitempty"`
+
+	// CurrentRaw is the current value of the option before it was processed by the service.
+	CurrentRaw interface{} `json:"currentRaw,omitempty"`
+}
+
+type FoxxServiceDependency struct {
+	Name        string `json:"name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+	Multiple    bool   `json:"multiple"`
+
+	// Current is the mount path (or the list of mount paths for multiple dependencies) the dependency is set to.
+	Current interface{} `json:"current,omitempty"`
+}
+
+// FoxxServiceSettingsResult is returned when the configuration or the dependencies of a service are changed.
+type FoxxServiceSettingsResult struct {
+	// Values contains all values after the change.
+	Values map[string]interface{} `json:"values,omitempty"`
+
+	// Warnings contains validation warnings per option name.
+	Warnings map[string]string `json:"warnings,omitempty"`
+}
+
+// FoxxServiceTestsReport is the report of the "default" tests reporter.
+type FoxxServiceTestsReport struct {
+	Stats    FoxxServiceTestsStats    `json:"stats"`
+	Tests    []FoxxServiceTestsResult `json:"tests,omitempty"`
+	Pending  []FoxxServiceTestsResult `json:"pending,omitempty"`
+	Failures []FoxxServiceTestsResult `json:"failures,omitempty"`
+	Passes   []FoxxServiceTestsResult `json:"passes,omitempty"`
+}
+
+type FoxxServiceTestsStats struct {
+	Suites   int `json:"suites"`
+	Tests    int `json:"tests"`
+	Passes   int `json:"passes"`
+	Pending  int `json:"pending"`
+	Failures int `json:"failures"`
+
+	// Duration of the test run in milliseconds.
+	Duration int `json:"duration"`
+}
+
+type FoxxServiceTestsResult struct {
+	Title     string                 `json:"title"`
+	FullTitle string                 `json:"fullTitle"`
+	Duration  int                    `json:"duration"`
+	Err       map[string]interface{} `json:"err,omitempty"`
+}
+
+// FoxxServiceSwagger is the Swagger (OpenAPI 2.0) description of the service API.
+type FoxxServiceSwagger struct {
+	Swagger  string                 `json:"swagger,omitempty"`
+	BasePath string                 `json:"basePath,omitempty"`
+	Info     map[string]interface{} `json:"info,omitempty"`
+	Paths    map[string]interface{} `json:"paths,omitempty"`
+}
@@ -0,0 +1,319 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseFoxx(db *database) *databaseFoxx {
+	return &databaseFoxx{
+		db: db,
+	}
+}
+
+var _ DatabaseFoxx = &databaseFoxx{}
+
+type databaseFoxx struct {
+	db *database
+}
+
+func (d databaseFoxx) ListFoxxServices(ctx context.Context, opts *FoxxListOptions) ([]FoxxServiceListItem, error) {
+	urlEndpoint := d.db.url("_api", "foxx")
+
+	var response []FoxxServiceListItem
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, urlEndpoint, &response,
+		[]int{http.StatusOK}, d.db.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseFoxx) GetFoxxService(ctx context.Context, mount string) (FoxxServiceDetails, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "service")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxServiceDetails    `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response, d.db.withModifiers(withFoxxMount(mount))...)
+	if err != nil {
+		return FoxxServiceDetails{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FoxxServiceDetails, nil
+	default:
+		return FoxxServiceDetails{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) InstallFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxInstallOptions) (FoxxServiceDetails, error) {
+	return d.sendFoxxService(ctx, http.MethodPost, d.db.url("_api", "foxx"), mount, source, http.StatusCreated, opts.modifyRequest)
+}
+
+func (d databaseFoxx) ReplaceFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxReplaceOptions) (FoxxServiceDetails, error) {
+	return d.sendFoxxService(ctx, http.MethodPut, d.db.url("_api", "foxx", "service"), mount, source, http.StatusOK, opts.modifyRequest)
+}
+
+func (d databaseFoxx) UpgradeFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxUpgradeOptions) (FoxxServiceDetails, error) {
+	return d.sendFoxxService(ctx, http.MethodPatch, d.db.url("_api", "foxx", "service"), mount, source, http.StatusOK, opts.modifyRequest)
+}
+
+func (d databaseFoxx) sendFoxxService(ctx context.Context, method, urlEndpoint, mount string, source FoxxServiceSource,
+	expectedCode int, modifier connection.RequestModifier) (FoxxServiceDetails, error) {
+	if err := source.validate(); err != nil {
+		return FoxxServiceDetails{}, errors.WithStack(err)
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxServiceDetails    `json:",inline"`
+	}
+
+	resp, err := connection.Call(ctx, d.db.connection(), method, urlEndpoint, &response,
+		d.db.withModifiers(withFoxxMount(mount), modifier, source.modifyRequest)...)
+	if err != nil {
+		return FoxxServiceDetails{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case expectedCode:
+		return response.FoxxServiceDetails, nil
+	default:
+		return FoxxServiceDetails{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) UninstallFoxxService(ctx context.Context, mount string, opts *FoxxUninstallOptions) error {
+	urlEndpoint := d.db.url("_api", "foxx", "service")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), urlEndpoint, &response, d.db.withModifiers(withFoxxMount(mount), opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) GetFoxxServiceConfiguration(ctx context.Context, mount string) (map[string]FoxxServiceConfigurationOption, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "configuration")
+
+	var response map[string]FoxxServiceConfigurationOption
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, urlEndpoint, &response,
+		[]int{http.StatusOK}, d.db.withModifiers(withFoxxMount(mount))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseFoxx) UpdateFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error) {
+	return d.changeFoxxServiceSettings(ctx, http.MethodPatch, "configuration", mount, values)
+}
+
+func (d databaseFoxx) ReplaceFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error) {
+	return d.changeFoxxServiceSettings(ctx, http.MethodPut, "configuration", mount, values)
+}
+
+func (d databaseFoxx) GetFoxxServiceDependencies(ctx context.Context, mount string) (map[string]FoxxServiceDependency, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "dependencies")
+
+	var response map[string]FoxxServiceDependency
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, urlEndpoint, &response,
+		[]int{http.StatusOK}, d.db.withModifiers(withFoxxMount(mount))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseFoxx) UpdateFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error) {
+	return d.changeFoxxServiceSettings(ctx, http.MethodPatch, "dependencies", mount, values)
+}
+
+func (d databaseFoxx) ReplaceFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error) {
+	return d.changeFoxxServiceSettings(ctx, http.MethodPut, "dependencies", mount, values)
+}
+
+func (d databaseFoxx) changeFoxxServiceSettings(ctx context.Context, method, settings, mount string, values map[string]interface{}) (FoxxServiceSettingsResult, error) {
+	urlEndpoint := d.db.url("_api", "foxx", settings)
+
+	var response struct {
+		shared.ResponseStruct     `json:",inline"`
+		FoxxServiceSettingsResult `json:",inline"`
+	}
+
+	// The minimal response contains only the values and the warnings.
+	resp, err := connection.Call(ctx, d.db.connection(), method, urlEndpoint, &response,
+		d.db.withModifiers(withFoxxMount(mount), connection.WithQuery("minimal", "true"), connection.WithBody(values))...)
+	if err != nil {
+		return FoxxServiceSettingsResult{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FoxxServiceSettingsResult, nil
+	default:
+		return FoxxServiceSettingsResult{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) EnableFoxxDevelopmentMode(ctx context.Context, mount string) (FoxxServiceDetails, error) {
+	return d.setFoxxDevelopmentMode(ctx, http.MethodPost, mount)
+}
+
+func (d databaseFoxx) DisableFoxxDevelopmentMode(ctx context.Context, mount string) (FoxxServiceDetails, error) {
+	return d.setFoxxDevelopmentMode(ctx, http.MethodDelete, mount)
+}
+
+func (d databaseFoxx) setFoxxDevelopmentMode(ctx context.Context, method, mount string) (FoxxServiceDetails, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "development")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxServiceDetails    `json:",inline"`
+	}
+
+	resp, err := connection.Call(ctx, d.db.connection(), method, urlEndpoint, &response, d.db.withModifiers(withFoxxMount(mount))...)
+	if err != nil {
+		return FoxxServiceDetails{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FoxxServiceDetails, nil
+	default:
+		return FoxxServiceDetails{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) ListFoxxServiceScripts(ctx context.Context, mount string) (map[string]string, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "scripts")
+
+	var response map[string]string
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, urlEndpoint, &response,
+		[]int{http.StatusOK}, d.db.withModifiers(withFoxxMount(mount))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseFoxx) RunFoxxServiceScript(ctx context.Context, mount string, name string, args interface{}, result interface{}) error {
+	urlEndpoint := d.db.url("_api", "foxx", "scripts", url.PathEscape(name))
+
+	if args == nil {
+		args = struct{}{}
+	}
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodPost, urlEndpoint, result,
+		[]int{http.StatusOK}, d.db.withModifiers(withFoxxMount(mount), connection.WithBody(args))...)
+	return errors.WithStack(err)
+}
+
+func (d databaseFoxx) RunFoxxServiceTests(ctx context.Context, mount string, opts *FoxxTestOptions, result interface{}) error {
+	urlEndpoint := d.db.url("_api", "foxx", "tests")
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodPost, urlEndpoint, result,
+		[]int{http.StatusOK}, d.db.withModifiers(withFoxxMount(mount), opts.modifyRequest, connection.WithBody(struct{}{}))...)
+	return errors.WithStack(err)
+}
+
+func (d databaseFoxx) DownloadFoxxServiceBundle(ctx context.Context, mount string) ([]byte, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "download")
+
+	var response []byte
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodPost, urlEndpoint, &response,
+		[]int{http.StatusOK}, d.db.withModifiers(withFoxxMount(mount), connection.WithHeader("Accept", connection.ApplicationZip))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseFoxx) GetFoxxServiceReadme(ctx context.Context, mount string) (string, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "readme")
+
+	var response []byte
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, urlEndpoint, &response,
+		[]int{http.StatusOK, http.StatusNoContent}, d.db.withModifiers(withFoxxMount(mount), connection.WithHeader("Accept", connection.PlainText))...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return string(response), nil
+}
+
+func (d databaseFoxx) GetFoxxServiceSwagger(ctx context.Context, mount string) (FoxxServiceSwagger, error) {
+	urlEndpoint := d.db.url("_api", "foxx", "swagger")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxServiceSwagger    `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response, d.db.withModifiers(withFoxxMount(mount))...)
+	if err != nil {
+		return FoxxServiceSwagger{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FoxxServiceSwagger, nil
+	default:
+		return FoxxServiceSwagger{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func withFoxxMount(mount string) connection.RequestModifier {
+	return connection.WithQuery("mount", mount)
+}
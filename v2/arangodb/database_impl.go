@@ -37,9 +37,14 @@ func newDatabase(c *client, name string, modifiers ...connection.RequestModifier
 	d.databaseCollection = newDatabaseCollection(d)
 	d.databaseTransaction = newDatabaseTransaction(d)
 	d.databaseQuery = newDatabaseQuery(d)
+	d.databaseQueryCache = newDatabaseQueryCache(d)
+	d.databaseAQLFunctions = newDatabaseAQLFunctions(d)
 	d.databaseView = newDatabaseView(d)
 	d.databaseAnalyzer = newDatabaseAnalyzer(d)
 	d.databaseGraph = newDatabaseGraph(d)
+	d.databaseFoxx = newDatabaseFoxx(d)
+	d.databasePregel = newDatabasePregel(d)
+	d.databaseExport = newDatabaseExport(d)
 
 	return d
 }
@@ -54,9 +59,14 @@ type database struct {
 	*databaseCollection
 	*databaseTransaction
 	*databaseQuery
+	*databaseQueryCache
+	*databaseAQLFunctions
 	*databaseView
 	*databaseAnalyzer
 	*databaseGraph
+	*databaseFoxx
+	*databasePregel
+	*databaseExport
 }
 
 func (d database) Remove(ctx context.Context) error {
@@ -79,6 +89,24 @@ func (d database) connection() connection.Connection {
 	return d.client.connection
 }
 
+func (d database) withModifiers(modifiers ...connection.RequestModifier) []connection.RequestModifier {
+	if len(modifiers) == 0 {
+		return d.modifiers
+	}
+
+	z := len(d.modifiers)
+
+	m := make([]connection.RequestModifier, len(modifiers)+z)
+
+	copy(m, d.modifiers)
+
+	for i, v := range modifiers {
+		m[i+z] = v
+	}
+
+	return m
+}
+
 func (d database) url(parts ...string) string {
 	return connection.NewUrl(append([]string{"_db", d.name}, parts...)...)
 }
@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// newTestDatabase returns the database "db" of the client connected with JSON to the test server with the given handler.
+func newTestDatabase(t *testing.T, handler http.Handler) *database {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	conn := connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:    connection.NewRoundRobinEndpoints([]string{server.URL}),
+		ContentType: connection.ApplicationJSON,
+	})
+
+	return newDatabase(newClient(conn), "db")
+}
+
+// testRequestLog records the requests received by the test server as "METHOD /path".
+type testRequestLog struct {
+	lock     sync.Mutex
+	requests []string
+}
+
+func (l *testRequestLog) add(r *http.Request) string {
+	request := r.Method + " " + r.URL.Path
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.requests = append(l.requests, request)
+	return request
+}
+
+func (l *testRequestLog) all() []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return append([]string{}, l.requests...)
+}
+
+func (l *testRequestLog) count(request string) int {
+	n := 0
+	for _, r := range l.all() {
+		if r == request {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,324 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"time"
+)
+
+// DatabasePregel provides access to the Pregel jobs of the database.
+// Pregel is not available in ArangoDB 3.12 and later.
+type DatabasePregel interface {
+	// StartPregelJob starts the execution of a Pregel algorithm and returns the ID of the job.
+	StartPregelJob(ctx context.Context, options PregelJobOptions) (string, error)
+
+	// GetPregelJob returns the status of a Pregel job.
+	GetPregelJob(ctx context.Context, id string) (PregelJob, error)
+
+	// GetPregelJobs returns a list of currently running and recently finished Pregel jobs without retrieving their results.
+	GetPregelJobs(ctx context.Context) ([]PregelJob, error)
+
+	// CancelPregelJob cancels an ongoing Pregel job.
+	CancelPregelJob(ctx context.Context, id string) error
+
+	// WaitForPregelJob polls the Pregel job until it reaches a terminal state (done, canceled or fatal error)
+	// or until the context is done. The last fetched job status is returned.
+	WaitForPregelJob(ctx context.Context, id string, opts *PregelWaitOptions) (PregelJob, error)
+}
+
+type PregelAlgorithm string
+
+const (
+	PregelAlgorithmPageRank                        PregelAlgorithm = "pagerank"
+	PregelAlgorithmSingleSourceShortestPath        PregelAlgorithm = "sssp"
+	PregelAlgorithmConnectedComponents             PregelAlgorithm = "connectedcomponents"
+	PregelAlgorithmWeaklyConnectedComponents       PregelAlgorithm = "wcc"
+	PregelAlgorithmStronglyConnectedComponents     PregelAlgorithm = "scc"
+	PregelAlgorithmHyperlinkInducedTopicSearch     PregelAlgorithm = "hits"
+	PregelAlgorithmEffectiveCloseness              PregelAlgorithm = "effectivecloseness"
+	PregelAlgorithmLineRank                        PregelAlgorithm = "linerank"
+	PregelAlgorithmLabelPropagation                PregelAlgorithm = "labelpropagation"
+	PregelAlgorithmSpeakerListenerLabelPropagation PregelAlgorithm = "slpa"
+)
+
+type PregelJobOptions struct {
+	// Algorithm is the name of the algorithm.
+	// It can be omitted when Params is set, then the algorithm of the Params is used.
+	Algorithm PregelAlgorithm `json:"algorithm"`
+
+	// GraphName is the name of a graph. Either this or the parameters VertexCollections and EdgeCollections are required.
+	// Please note that there are special sharding requirements for graphs in order to be used with Pregel.
+	GraphName string `json:"graphName,omitempty"`
+
+	// VertexCollections is a list of vertex collection names.
+	// Please note that there are special sharding requirements for collections in order to be used with Pregel.
+	VertexCollections []string `json:"vertexCollections,omitempty"`
+
+	// EdgeCollections is a list of edge collection names.
+	// Please note that there are special sharding requirements for collections in order to be used with Pregel.
+	EdgeCollections []string `json:"edgeCollections,omitempty"`
+
+	// Params contains general as well as algorithm-specific options, e.g. PregelPageRankParams.
+	Params PregelAlgorithmParams `json:"params,omitempty"`
+}
+
+// PregelAlgorithmParams is implemented by the parameters of the Pregel algorithms.
+type PregelAlgorithmParams interface {
+	// PregelAlgorithm returns the algorithm the parameters belong to.
+	PregelAlgorithm() PregelAlgorithm
+}
+
+// PregelParams contains the parameters which are common for all Pregel algorithms.
+type PregelParams struct {
+	// Store defines whether the results are written back into the vertex collections. Default: true.
+	Store *bool `json:"store,omitempty"`
+
+	// MaxGSS is the maximum number of global iterations for the algorithm.
+	MaxGSS *int `json:"maxGSS,omitempty"`
+
+	// Parallelism is the number of parallel threads to use per worker.
+	Parallelism *int `json:"parallelism,omitempty"`
+
+	// Async defines whether the algorithm is executed asynchronously (if supported by the algorithm).
+	Async *bool `json:"async,omitempty"`
+
+	// ResultField is the attribute of the vertices to write the result into.
+	ResultField string `json:"resultField,omitempty"`
+
+	// UseMemoryMaps defines whether to use disk based files to store temporary results.
+	UseMemoryMaps *bool `json:"useMemoryMaps,omitempty"`
+
+	// ShardKeyAttribute is the shard key that edge collections are sharded after. Default: "vertex".
+	ShardKeyAttribute string `json:"shardKeyAttribute,omitempty"`
+}
+
+// PregelPageRankParams are the parameters of the PageRank algorithm.
+type PregelPageRankParams struct {
+	PregelParams
+
+	// Threshold is the convergence threshold. Default: 0.00001.
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// SourceField is the attribute of the vertices to read the initial rank value from (Seeded PageRank).
+	SourceField string `json:"sourceField,omitempty"`
+}
+
+func (p PregelPageRankParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmPageRank
+}
+
+// PregelSSSPParams are the parameters of the Single-Source Shortest Path algorithm.
+type PregelSSSPParams struct {
+	PregelParams
+
+	// Source is the vertex ID (_id) to calculate the distances from.
+	Source string `json:"source"`
+}
+
+func (p PregelSSSPParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmSingleSourceShortestPath
+}
+
+// PregelWCCParams are the parameters of the Weakly Connected Components algorithm.
+type PregelWCCParams struct {
+	PregelParams
+}
+
+func (p PregelWCCParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmWeaklyConnectedComponents
+}
+
+// PregelSCCParams are the parameters of the Strongly Connected Components algorithm.
+type PregelSCCParams struct {
+	PregelParams
+}
+
+func (p PregelSCCParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmStronglyConnectedComponents
+}
+
+// PregelHITSParams are the parameters of the Hyperlink-Induced Topic Search algorithm.
+type PregelHITSParams struct {
+	PregelParams
+
+	// Threshold is the convergence threshold. Default: 0.00001.
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+func (p PregelHITSParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmHyperlinkInducedTopicSearch
+}
+
+// PregelLabelPropagationParams are the parameters of the Label Propagation algorithm.
+type PregelLabelPropagationParams struct {
+	PregelParams
+}
+
+func (p PregelLabelPropagationParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmLabelPropagation
+}
+
+// PregelSLPAParams are the parameters of the Speaker-Listener Label Propagation algorithm.
+type PregelSLPAParams struct {
+	PregelParams
+
+	// MaxCommunities is the maximum number of communities a vertex can be assigned to. Default: 1.
+	MaxCommunities *int `json:"maxCommunities,omitempty"`
+}
+
+func (p PregelSLPAParams) PregelAlgorithm() PregelAlgorithm {
+	return PregelAlgorithmSpeakerListenerLabelPropagation
+}
+
+type PregelJobState string
+
+const (
+	// PregelJobStateNone - The Pregel run did not yet start.
+	PregelJobStateNone PregelJobState = "none"
+	// PregelJobStateLoading - The graph is loaded from the database into memory before the execution of the algorithm.
+	PregelJobStateLoading PregelJobState = "loading"
+	// PregelJobStateRunning - The algorithm is executing normally.
+	PregelJobStateRunning PregelJobState = "running"
+	// PregelJobStateStoring - The algorithm finished, but the results are still being written back into the collections.
+	// Occurs only if the store parameter is set to true.
+	PregelJobStateStoring PregelJobState = "storing"
+	// PregelJobStateDone - The execution is done.
+	PregelJobStateDone PregelJobState = "done"
+	// PregelJobStateCanceled - The execution was permanently canceled, either by the user or by an error.
+	PregelJobStateCanceled PregelJobState = "canceled"
+	// PregelJobStateFatalError - The execution has failed and cannot recover.
+	PregelJobStateFatalError PregelJobState = "fatal error"
+	// PregelJobStateInError - The execution is in an error state. This can be caused by DB-Servers being not reachable or being non-responsive.
+	// The execution might recover later, or switch to "canceled" if it was not able to recover successfully.
+	PregelJobStateInError PregelJobState = "in error"
+	// PregelJobStateRecovering - The execution is actively recovering and switches back to running if the recovery is successful.
+	PregelJobStateRecovering PregelJobState = "recovering"
+)
+
+// IsTerminal returns true if the job will not change its state anymore.
+func (p PregelJobState) IsTerminal() bool {
+	switch p {
+	case PregelJobStateDone, PregelJobStateCanceled, PregelJobStateFatalError:
+		return true
+	default:
+		return false
+	}
+}
+
+type PregelJob struct {
+	// ID of the Pregel job, as a string.
+	ID string `json:"id"`
+	// Algorithm used by the job.
+	Algorithm PregelAlgorithm `json:"algorithm,omitempty"`
+	// Created is the date and time when the job was created.
+	Created time.Time `json:"created,omitempty"`
+	// Expires is the date and time when the job results expire.
+	// The expiration date is only meaningful for jobs that were completed, canceled or resulted in an error.
+	Expires *time.Time `json:"expires,omitempty"`
+	// TTL (time to live) value for the job results, specified in seconds.
+	TTL uint64 `json:"ttl,omitempty"`
+	// State of the execution.
+	State PregelJobState `json:"state,omitempty"`
+	// Gss is the number of global supersteps executed.
+	Gss uint64 `json:"gss,omitempty"`
+	// TotalRuntime is the total runtime of the execution up to now (if the execution is still ongoing).
+	TotalRuntime float64 `json:"totalRuntime,omitempty"`
+	// StartupTime is the startup runtime of the execution. The startup time includes the data loading time and can be substantial.
+	StartupTime float64 `json:"startupTime,omitempty"`
+	// ComputationTime is the algorithm execution time. Is shown when the computation started.
+	ComputationTime float64 `json:"computationTime,omitempty"`
+	// StorageTime is the time for storing the results if the job includes results storage. Is shown when the storing started.
+	StorageTime float64 `json:"storageTime,omitempty"`
+	// GSSTimes is the computation time of each global super step. Is shown when the computation started.
+	GSSTimes []float64 `json:"gssTimes,omitempty"`
+	// Reports is used by Programmable Pregel Algorithms. The value is only populated once the algorithm has finished.
+	Reports []map[string]interface{} `json:"reports,omitempty"`
+	// VertexCount is the total number of vertices processed.
+	VertexCount uint64 `json:"vertexCount,omitempty"`
+	// EdgeCount is the total number of edges processed.
+	EdgeCount uint64 `json:"edgeCount,omitempty"`
+	// UseMemoryMaps defines whether disk based files are used to store temporary results.
+	UseMemoryMaps *bool `json:"useMemoryMaps,omitempty"`
+	// Detail contains the Pregel run details.
+	Detail *PregelRunDetails `json:"detail,omitempty"`
+}
+
+type PregelRunDetails struct {
+	// AggregatedStatus contains the aggregated details of the full Pregel run. The values are totals of all the DB-Server.
+	AggregatedStatus *PregelAggregatedStatus `json:"aggregatedStatus,omitempty"`
+	// WorkerStatus contains the details of the Pregel for every DB-Server.
+	// In a single server deployment, there is only a single entry with an empty string as key.
+	WorkerStatus map[string]*PregelAggregatedStatus `json:"workerStatus,omitempty"`
+}
+
+type PregelAggregatedStatus struct {
+	// TimeStamp is the time at which the status was measured.
+	TimeStamp time.Time `json:"timeStamp,omitempty"`
+	// GraphStoreStatus is the status of the in memory graph.
+	GraphStoreStatus *PregelGraphStoreStatus `json:"graphStoreStatus,omitempty"`
+	// AllGSSStatus contains information about the global supersteps.
+	AllGSSStatus *PregelAllGSSStatus `json:"allGssStatus,omitempty"`
+}
+
+type PregelGraphStoreStatus struct {
+	// VerticesLoaded is the number of vertices that are loaded from the database into memory.
+	VerticesLoaded uint64 `json:"verticesLoaded,omitempty"`
+	// EdgesLoaded is the number of edges that are loaded from the database into memory.
+	EdgesLoaded uint64 `json:"edgesLoaded,omitempty"`
+	// MemoryBytesUsed is the number of bytes used in-memory for the loaded graph.
+	MemoryBytesUsed uint64 `json:"memoryBytesUsed,omitempty"`
+	// VerticesStored is the number of vertices that are written back to the database after the Pregel computation finished.
+	VerticesStored uint64 `json:"verticesStored,omitempty"`
+}
+
+type PregelAllGSSStatus struct {
+	// Items contains details for each global superstep.
+	Items []PregelGSSStatus `json:"items,omitempty"`
+}
+
+type PregelGSSStatus struct {
+	// VerticesProcessed is the number of vertices that have been processed in this step.
+	VerticesProcessed uint64 `json:"verticesProcessed,omitempty"`
+	// MessagesSent is the number of messages sent in this step.
+	MessagesSent uint64 `json:"messagesSent,omitempty"`
+	// MessagesReceived is the number of messages received in this step.
+	MessagesReceived uint64 `json:"messagesReceived,omitempty"`
+	// MemoryBytesUsedForMessages is the number of bytes used in memory for the messages in this step.
+	MemoryBytesUsedForMessages uint64 `json:"memoryBytesUsedForMessages,omitempty"`
+}
+
+type PregelWaitOptions struct {
+	// Interval between two polls of the job status. Default: 1 second.
+	Interval time.Duration
+
+	// OnProgress is called with the job status every time the job advances to the next global superstep
+	// or changes its state.
+	OnProgress func(job PregelJob)
+}
+
+func (p *PregelWaitOptions) getInterval() time.Duration {
+	if p == nil || p.Interval <= 0 {
+		return time.Second
+	}
+
+	return p.Interval
+}
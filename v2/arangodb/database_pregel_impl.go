@@ -0,0 +1,140 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabasePregel(db *database) *databasePregel {
+	return &databasePregel{
+		db: db,
+	}
+}
+
+var _ DatabasePregel = &databasePregel{}
+
+type databasePregel struct {
+	db *database
+}
+
+func (d databasePregel) StartPregelJob(ctx context.Context, options PregelJobOptions) (string, error) {
+	urlEndpoint := d.db.url("_api", "control_pregel")
+
+	if options.Algorithm == "" && options.Params != nil {
+		options.Algorithm = options.Params.PregelAlgorithm()
+	}
+
+	if options.Algorithm == "" {
+		return "", errors.WithStack(shared.InvalidArgumentError{Message: "algorithm must be set"})
+	}
+
+	var id string
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodPost, urlEndpoint, &id,
+		[]int{http.StatusOK}, d.db.withModifiers(connection.WithBody(options))...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return id, nil
+}
+
+func (d databasePregel) GetPregelJob(ctx context.Context, id string) (PregelJob, error) {
+	urlEndpoint := d.db.url("_api", "control_pregel", url.PathEscape(id))
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		PregelJob             `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), urlEndpoint, &response, d.db.modifiers...)
+	if err != nil {
+		return PregelJob{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.PregelJob, nil
+	default:
+		return PregelJob{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databasePregel) GetPregelJobs(ctx context.Context) ([]PregelJob, error) {
+	urlEndpoint := d.db.url("_api", "control_pregel")
+
+	var response []PregelJob
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, urlEndpoint, &response,
+		[]int{http.StatusOK}, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databasePregel) CancelPregelJob(ctx context.Context, id string) error {
+	urlEndpoint := d.db.url("_api", "control_pregel", url.PathEscape(id))
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodDelete, urlEndpoint, nil,
+		[]int{http.StatusOK}, d.db.modifiers...)
+	return errors.WithStack(err)
+}
+
+func (d databasePregel) WaitForPregelJob(ctx context.Context, id string, opts *PregelWaitOptions) (PregelJob, error) {
+	ticker := time.NewTicker(opts.getInterval())
+	defer ticker.Stop()
+
+	var last *PregelJob
+	for {
+		job, err := d.GetPregelJob(ctx, id)
+		if err != nil {
+			return job, err
+		}
+
+		if opts != nil && opts.OnProgress != nil {
+			if last == nil || last.Gss != job.Gss || last.State != job.State {
+				opts.OnProgress(job)
+			}
+		}
+		last = &job
+
+		if job.State.IsTerminal() {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, errors.WithStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func TestPregelJobOptionsParams(t *testing.T) {
+	opts := PregelJobOptions{
+		Algorithm: PregelAlgorithmSingleSourceShortestPath,
+		GraphName: "graph",
+		Params: PregelSSSPParams{
+			PregelParams: PregelParams{
+				MaxGSS:      utils.NewType(10),
+				ResultField: "distance",
+			},
+			Source: "vertices/1",
+		},
+	}
+
+	data, err := json.Marshal(opts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"algorithm": "sssp",
+		"graphName": "graph",
+		"params": {"maxGSS": 10, "resultField": "distance", "source": "vertices/1"}
+	}`, string(data))
+}
+
+func TestPregelJobStateIsTerminal(t *testing.T) {
+	terminal := map[PregelJobState]bool{
+		PregelJobStateNone:       false,
+		PregelJobStateLoading:    false,
+		PregelJobStateRunning:    false,
+		PregelJobStateStoring:    false,
+		PregelJobStateInError:    false,
+		PregelJobStateRecovering: false,
+		PregelJobStateDone:       true,
+		PregelJobStateCanceled:   true,
+		PregelJobStateFatalError: true,
+	}
+
+	for state, expected := range terminal {
+		assert.Equal(t, expected, state.IsTerminal(), string(state))
+	}
+}
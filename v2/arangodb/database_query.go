@@ -43,6 +43,87 @@ type DatabaseQuery interface {
 
 	// ExplainQuery explains an AQL query and return information about it.
 	ExplainQuery(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (ExplainQueryResult, error)
+
+	// ListOfRunningAQLQueries returns a list of currently running AQL queries.
+	// If all is set to true, then the queries of all databases are returned. It is only allowed on the _system database.
+	ListOfRunningAQLQueries(ctx context.Context, all *bool) ([]RunningAQLQuery, error)
+
+	// ListOfSlowAQLQueries returns a list of the slow AQL queries which have been tracked and completed.
+	// The size of the list is limited by QueryProperties.MaxSlowQueries.
+	// If all is set to true, then the queries of all databases are returned. It is only allowed on the _system database.
+	ListOfSlowAQLQueries(ctx context.Context, all *bool) ([]RunningAQLQuery, error)
+
+	// ClearSlowAQLQueries clears the list of slow AQL queries.
+	// If all is set to true, then the queries of all databases are cleared. It is only allowed on the _system database.
+	ClearSlowAQLQueries(ctx context.Context, all *bool) error
+
+	// KillAQLQuery kills a running AQL query with the given ID. The query is terminated at the next cancellation point.
+	// If all is set to true, then the query may belong to any database. It is only allowed on the _system database.
+	KillAQLQuery(ctx context.Context, queryID string, all *bool) error
+
+	// GetAQLQueryProperties returns the current configuration of the AQL query tracking.
+	GetAQLQueryProperties(ctx context.Context) (QueryProperties, error)
+
+	// UpdateAQLQueryProperties changes the configuration of the AQL query tracking and returns the new configuration.
+	// Only the fields which are set are changed.
+	UpdateAQLQueryProperties(ctx context.Context, options QueryProperties) (QueryProperties, error)
+}
+
+// RunningAQLQuery contains the details of the running or slow AQL query.
+type RunningAQLQuery struct {
+	// ID is the ID of the query.
+	ID string `json:"id,omitempty"`
+
+	// Database is the name of the database the query runs in.
+	Database string `json:"database,omitempty"`
+
+	// User is the name of the user who started the query.
+	User string `json:"user,omitempty"`
+
+	// Query is the query string, which is truncated to QueryProperties.MaxQueryStringLength.
+	Query string `json:"query,omitempty"`
+
+	// BindVars contains the bind parameters of the query. It is set only if QueryProperties.TrackBindVars is enabled.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+
+	// Started is the date and time when the query was started, e.g. "2024-01-01T10:00:00Z".
+	Started string `json:"started,omitempty"`
+
+	// RunTime is the query's run time up to the moment when the list was fetched (in seconds).
+	RunTime float64 `json:"runTime,omitempty"`
+
+	// PeakMemoryUsage is the query's peak memory usage (in bytes).
+	PeakMemoryUsage uint64 `json:"peakMemoryUsage,omitempty"`
+
+	// State is the query's current execution state, e.g. "executing" or "finished" for the slow queries.
+	State string `json:"state,omitempty"`
+
+	// Stream is true if the query uses a streaming cursor.
+	Stream bool `json:"stream,omitempty"`
+}
+
+// QueryProperties contains the configuration of the AQL query tracking.
+type QueryProperties struct {
+	// Enabled if set to true, then the queries are tracked.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// TrackSlowQueries if set to true, then the slow queries are tracked in the list of slow queries.
+	TrackSlowQueries *bool `json:"trackSlowQueries,omitempty"`
+
+	// TrackBindVars if set to true, then the bind parameters are tracked with the queries.
+	TrackBindVars *bool `json:"trackBindVars,omitempty"`
+
+	// MaxSlowQueries is the maximum number of slow queries to keep in the list of slow queries.
+	MaxSlowQueries *int `json:"maxSlowQueries,omitempty"`
+
+	// SlowQueryThreshold is the threshold (in seconds) for treating a query as slow.
+	SlowQueryThreshold *float64 `json:"slowQueryThreshold,omitempty"`
+
+	// SlowStreamingQueryThreshold is the threshold (in seconds) for treating a streaming query as slow.
+	SlowStreamingQueryThreshold *float64 `json:"slowStreamingQueryThreshold,omitempty"`
+
+	// MaxQueryStringLength is the maximum length of the query strings which are tracked. Longer queries are truncated.
+	MaxQueryStringLength *int `json:"maxQueryStringLength,omitempty"`
 }
 
 type QuerySubOptions struct {
@@ -196,6 +277,13 @@ type QueryOptions struct {
 	// DatabaseTransaction.BeginTransaction() method.
 	TransactionID string `json:"-"`
 
+	// Prefetch enables fetching the next batch of the cursor in the background while the current batch is being read.
+	// It reduces the idle time of the consumer of large results, at the cost of keeping two batches in memory.
+	// The prefetching stops when the cursor is closed.
+	// If `AllowRetry` is set, RetryReadBatch returns the current batch from memory if the next batch has been already
+	// prefetched, because the server keeps only the latest batch.
+	Prefetch bool `json:"-"`
+
 	// Indicates whether the number of documents in the result set should be returned in the "count" attribute of the result.
 	// Calculating the "count" attribute might have a performance impact for some queries in the future so this option is
 	// turned off by default, and "count" is only returned when requested.
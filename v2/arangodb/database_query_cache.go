@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// DatabaseQueryCache provides access to the AQL query results cache of the server.
+// The cache is global for the server, so the changes apply to all databases.
+type DatabaseQueryCache interface {
+	// GetQueryCacheProperties returns the global configuration of the AQL query results cache.
+	GetQueryCacheProperties(ctx context.Context) (QueryCacheProperties, error)
+
+	// SetQueryCacheProperties changes the global configuration of the AQL query results cache
+	// and returns the new configuration. Only the fields which are set are changed.
+	SetQueryCacheProperties(ctx context.Context, properties QueryCacheProperties) (QueryCacheProperties, error)
+
+	// GetQueryCacheEntries returns the entries of the AQL query results cache of the database.
+	GetQueryCacheEntries(ctx context.Context) ([]QueryCacheEntry, error)
+
+	// ClearQueryCache removes all entries from the AQL query results cache of the database.
+	ClearQueryCache(ctx context.Context) error
+}
+
+// QueryCacheMode is the mode of the AQL query results cache.
+type QueryCacheMode string
+
+const (
+	// QueryCacheModeOff means that the query results cache is disabled.
+	QueryCacheModeOff QueryCacheMode = "off"
+	// QueryCacheModeOn means that the results of all cacheable queries are stored in the cache,
+	// unless the query sets the cache option to false.
+	QueryCacheModeOn QueryCacheMode = "on"
+	// QueryCacheModeDemand means that only the results of the queries with the cache option set to true are stored in the cache.
+	QueryCacheModeDemand QueryCacheMode = "demand"
+)
+
+// QueryCacheProperties contains the global configuration of the AQL query results cache.
+type QueryCacheProperties struct {
+	// Mode is the mode the query results cache operates in.
+	Mode QueryCacheMode `json:"mode,omitempty"`
+
+	// MaxResults is the maximum number of query results stored per database-specific cache.
+	MaxResults *uint64 `json:"maxResults,omitempty"`
+
+	// MaxResultsSize is the maximum cumulated size of query results stored per database-specific cache (in bytes).
+	MaxResultsSize *uint64 `json:"maxResultsSize,omitempty"`
+
+	// MaxEntrySize is the maximum size of an individual cache entry (in bytes).
+	MaxEntrySize *uint64 `json:"maxEntrySize,omitempty"`
+
+	// IncludeSystem if set to true, then the results of queries which involve system collections are stored too.
+	IncludeSystem *bool `json:"includeSystem,omitempty"`
+}
+
+// QueryCacheEntry is a single entry of the AQL query results cache.
+type QueryCacheEntry struct {
+	// Hash is the hash value calculated from the query string and certain query options.
+	Hash string `json:"hash,omitempty"`
+
+	// Query is the query string.
+	Query string `json:"query,omitempty"`
+
+	// BindVars contains the bind parameters of the query. It is set only if the query tracking of bind parameters is enabled.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+
+	// Size is the size of the query result and the bind parameters (in bytes).
+	Size uint64 `json:"size,omitempty"`
+
+	// Results is the number of documents/rows in the query result.
+	Results uint64 `json:"results,omitempty"`
+
+	// Hits is the number of times the result was served from the cache.
+	Hits uint64 `json:"hits,omitempty"`
+
+	// RunTime is the total duration of the query which created the entry (in seconds).
+	RunTime float64 `json:"runTime,omitempty"`
+
+	// Started is the date and time when the query which created the entry was started.
+	Started string `json:"started,omitempty"`
+
+	// DataSources is the list of the collections and views the query used.
+	DataSources []string `json:"dataSources,omitempty"`
+}
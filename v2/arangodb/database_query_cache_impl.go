@@ -0,0 +1,118 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseQueryCache(db *database) *databaseQueryCache {
+	return &databaseQueryCache{
+		db: db,
+	}
+}
+
+var _ DatabaseQueryCache = &databaseQueryCache{}
+
+type databaseQueryCache struct {
+	db *database
+}
+
+func (d databaseQueryCache) GetQueryCacheProperties(ctx context.Context) (QueryCacheProperties, error) {
+	url := d.db.url("_api", "query-cache", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryCacheProperties  `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return QueryCacheProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryCacheProperties, nil
+	default:
+		return QueryCacheProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQueryCache) SetQueryCacheProperties(ctx context.Context, properties QueryCacheProperties) (QueryCacheProperties, error) {
+	url := d.db.url("_api", "query-cache", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryCacheProperties  `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, d.db.connection(), url, &response, &properties, d.db.modifiers...)
+	if err != nil {
+		return QueryCacheProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryCacheProperties, nil
+	default:
+		return QueryCacheProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQueryCache) GetQueryCacheEntries(ctx context.Context) ([]QueryCacheEntry, error) {
+	url := d.db.url("_api", "query-cache", "entries")
+
+	var response []QueryCacheEntry
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, url, &response, []int{http.StatusOK}, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseQueryCache) ClearQueryCache(ctx context.Context) error {
+	url := d.db.url("_api", "query-cache")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
@@ -25,6 +25,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/pkg/errors"
+
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
 
 	"github.com/arangodb/go-driver/v2/connection"
@@ -74,7 +76,11 @@ func (d databaseQuery) getCursor(ctx context.Context, query string, opts *QueryO
 				return nil, err
 			}
 		}
-		return newCursor(d.db, resp.Endpoint(), response.cursorData), nil
+		c := newCursor(d.db, resp.Endpoint(), response.cursorData)
+		if opts != nil && opts.Prefetch {
+			c.enablePrefetch(ctx)
+		}
+		return c, nil
 	default:
 		return nil, response.AsArangoErrorWithCode(code)
 	}
@@ -134,3 +140,117 @@ func (d databaseQuery) ExplainQuery(ctx context.Context, query string, bindVars
 		return ExplainQueryResult{}, response.AsArangoErrorWithCode(code)
 	}
 }
+
+func (d databaseQuery) ListOfRunningAQLQueries(ctx context.Context, all *bool) ([]RunningAQLQuery, error) {
+	return d.listAQLQueries(ctx, "current", all)
+}
+
+func (d databaseQuery) ListOfSlowAQLQueries(ctx context.Context, all *bool) ([]RunningAQLQuery, error) {
+	return d.listAQLQueries(ctx, "slow", all)
+}
+
+func (d databaseQuery) listAQLQueries(ctx context.Context, kind string, all *bool) ([]RunningAQLQuery, error) {
+	url := d.db.url("_api", "query", kind)
+
+	var response []RunningAQLQuery
+
+	_, err := connection.CallWithChecks(ctx, d.db.connection(), http.MethodGet, url, &response, []int{http.StatusOK},
+		d.db.withModifiers(withAllDatabases(all))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return response, nil
+}
+
+func (d databaseQuery) ClearSlowAQLQueries(ctx context.Context, all *bool) error {
+	url := d.db.url("_api", "query", "slow")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.withModifiers(withAllDatabases(all))...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) KillAQLQuery(ctx context.Context, queryID string, all *bool) error {
+	url := d.db.url("_api", "query", queryID)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.withModifiers(withAllDatabases(all))...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) GetAQLQueryProperties(ctx context.Context) (QueryProperties, error) {
+	url := d.db.url("_api", "query", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryProperties       `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return QueryProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryProperties, nil
+	default:
+		return QueryProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) UpdateAQLQueryProperties(ctx context.Context, options QueryProperties) (QueryProperties, error) {
+	url := d.db.url("_api", "query", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryProperties       `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, d.db.connection(), url, &response, &options, d.db.modifiers...)
+	if err != nil {
+		return QueryProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryProperties, nil
+	default:
+		return QueryProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// withAllDatabases adds the "all" query parameter, which makes the query management operation apply to all databases.
+func withAllDatabases(all *bool) connection.RequestModifier {
+	return func(r connection.Request) error {
+		if all != nil {
+			r.AddQuery("all", boolToString(*all))
+		}
+		return nil
+	}
+}
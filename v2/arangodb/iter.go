@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"iter"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// CursorSeq returns the iterator over the documents of type T of the cursor, e.g.
+//
+//	for doc, err := range arangodb.CursorSeq[MyDocument](ctx, cursor) {
+//
+// The iteration stops after the first error. The cursor is closed when the iteration ends, also when the loop exits early.
+func CursorSeq[T any](ctx context.Context, cursor Cursor) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer cursor.Close()
+
+		for {
+			var document T
+
+			_, err := cursor.ReadDocument(ctx, &document)
+			if shared.IsNoMoreDocuments(err) {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			if !yield(document, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CollectionDocumentCreateResponseSeq returns the iterator over the responses of the created documents.
+// The error of the document is returned with its response and the iteration continues with the next document.
+func CollectionDocumentCreateResponseSeq(reader CollectionDocumentCreateResponseReader) iter.Seq2[CollectionDocumentCreateResponse, error] {
+	return responseReaderSeq(reader.Read, true)
+}
+
+// CollectionDocumentReadResponseSeq returns the iterator over the read documents of type T.
+// The error of the document is returned with its response and the iteration continues with the next document.
+func CollectionDocumentReadResponseSeq[T any](reader CollectionDocumentReadResponseReader) iter.Seq2[TypedCollectionDocumentReadResponse[T], error] {
+	return responseReaderSeq(func() (TypedCollectionDocumentReadResponse[T], error) {
+		var document T
+
+		meta, err := reader.Read(&document)
+		return TypedCollectionDocumentReadResponse[T]{
+			DocumentMeta:   meta.DocumentMeta,
+			ResponseStruct: meta.ResponseStruct,
+			Document:       document,
+		}, err
+	}, true)
+}
+
+// GraphsSeq returns the iterator over the graphs. The iteration stops after the first error.
+func GraphsSeq(reader GraphsResponseReader) iter.Seq2[Graph, error] {
+	return responseReaderSeq(reader.Read, false)
+}
+
+// ViewsSeq returns the iterator over the views. The iteration stops after the first error.
+func ViewsSeq(reader ViewsResponseReader) iter.Seq2[View, error] {
+	return responseReaderSeq(reader.Read, false)
+}
+
+// AnalyzersSeq returns the iterator over the analyzers. The iteration stops after the first error.
+func AnalyzersSeq(reader AnalyzersResponseReader) iter.Seq2[Analyzer, error] {
+	return responseReaderSeq(reader.Read, false)
+}
+
+// responseReaderSeq returns the iterator over the items returned by the read function until shared.NoMoreDocumentsError.
+// If continueOnArangoError is true, the iteration continues after the ArangoError of a single item,
+// e.g. when a document of the batch operation can not be created.
+func responseReaderSeq[T any](read func() (T, error), continueOnArangoError bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			item, err := read()
+			if shared.IsNoMoreDocuments(err) {
+				return
+			}
+
+			if !yield(item, err) {
+				return
+			}
+
+			if err != nil {
+				if ok, _ := shared.IsArangoError(err); !ok || !continueOnArangoError {
+					return
+				}
+			}
+		}
+	}
+}
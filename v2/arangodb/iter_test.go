@@ -0,0 +1,187 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// sliceCursor is the Cursor which reads the documents from the slice.
+type sliceCursor struct {
+	Cursor
+
+	documents []string
+	err       error
+	closed    int
+}
+
+func (s *sliceCursor) ReadDocument(_ context.Context, result interface{}) (DocumentMeta, error) {
+	if len(s.documents) == 0 {
+		if s.err != nil {
+			return DocumentMeta{}, s.err
+		}
+		return DocumentMeta{}, shared.NoMoreDocumentsError{}
+	}
+
+	document := s.documents[0]
+	s.documents = s.documents[1:]
+	return DocumentMeta{Key: document}, json.Unmarshal([]byte(`{"name":"`+document+`"}`), result)
+}
+
+func (s *sliceCursor) Close() error {
+	s.closed++
+	return nil
+}
+
+type iterDoc struct {
+	Name string `json:"name"`
+}
+
+func TestCursorSeq(t *testing.T) {
+	t.Run("all documents", func(t *testing.T) {
+		c := &sliceCursor{documents: []string{"a", "b", "c"}}
+
+		var names []string
+		for doc, err := range NewTypedCursor[iterDoc](c).All(context.Background()) {
+			require.NoError(t, err)
+			names = append(names, doc.Name)
+		}
+
+		assert.Equal(t, []string{"a", "b", "c"}, names)
+		assert.Equal(t, 1, c.closed)
+	})
+
+	t.Run("early exit closes cursor", func(t *testing.T) {
+		c := &sliceCursor{documents: []string{"a", "b", "c"}}
+
+		for doc, err := range CursorSeq[iterDoc](context.Background(), c) {
+			require.NoError(t, err)
+			assert.Equal(t, "a", doc.Name)
+			break
+		}
+
+		assert.Equal(t, 1, c.closed)
+		assert.Len(t, c.documents, 2)
+	})
+
+	t.Run("error stops iteration", func(t *testing.T) {
+		c := &sliceCursor{documents: []string{"a"}, err: errors.New("failed")}
+
+		var errs []error
+		for _, err := range CursorSeq[iterDoc](context.Background(), c) {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 2)
+		assert.NoError(t, errs[0])
+		assert.EqualError(t, errs[1], "failed")
+		assert.Equal(t, 1, c.closed)
+	})
+}
+
+type sliceCreateReader struct {
+	responses []CollectionDocumentCreateResponse
+	errs      []error
+}
+
+func (s *sliceCreateReader) Read() (CollectionDocumentCreateResponse, error) {
+	if len(s.responses) == 0 {
+		return CollectionDocumentCreateResponse{}, shared.NoMoreDocumentsError{}
+	}
+
+	response, err := s.responses[0], s.errs[0]
+	s.responses, s.errs = s.responses[1:], s.errs[1:]
+	return response, err
+}
+
+func TestCollectionDocumentCreateResponseSeq(t *testing.T) {
+	conflict := shared.ArangoError{HasError: true, Code: 409, ErrorNum: shared.ErrArangoUniqueConstraintViolated}
+
+	t.Run("document errors do not stop iteration", func(t *testing.T) {
+		reader := &sliceCreateReader{
+			responses: []CollectionDocumentCreateResponse{{DocumentMeta: DocumentMeta{Key: "a"}}, {}, {DocumentMeta: DocumentMeta{Key: "c"}}},
+			errs:      []error{nil, conflict, nil},
+		}
+
+		var keys []string
+		var errs []error
+		for response, err := range CollectionDocumentCreateResponseSeq(reader) {
+			keys = append(keys, response.Key)
+			errs = append(errs, err)
+		}
+
+		assert.Equal(t, []string{"a", "", "c"}, keys)
+		assert.Equal(t, []error{nil, conflict, nil}, errs)
+	})
+
+	t.Run("other errors stop iteration", func(t *testing.T) {
+		reader := &sliceCreateReader{
+			responses: []CollectionDocumentCreateResponse{{}, {}},
+			errs:      []error{errors.New("decode"), nil},
+		}
+
+		count := 0
+		for _, err := range CollectionDocumentCreateResponseSeq(reader) {
+			require.Error(t, err)
+			count++
+		}
+		assert.Equal(t, 1, count)
+	})
+}
+
+type sliceGraphsReader struct {
+	graphs []Graph
+	err    error
+}
+
+func (s *sliceGraphsReader) Read() (Graph, error) {
+	if len(s.graphs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, shared.NoMoreDocumentsError{}
+	}
+
+	g := s.graphs[0]
+	s.graphs = s.graphs[1:]
+	return g, nil
+}
+
+func TestGraphsSeq(t *testing.T) {
+	reader := &sliceGraphsReader{graphs: []Graph{nil, nil}, err: shared.ArangoError{HasError: true, Code: 500}}
+
+	var errs []error
+	for _, err := range GraphsSeq(reader) {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[1])
+	assert.Error(t, errs[2], "error of the reader stops the iteration")
+}
@@ -34,7 +34,24 @@ func Call(ctx context.Context, c Connection, method, url string, output interfac
 }
 
 func CallWithChecks(ctx context.Context, c Connection, method, url string, output interface{}, allowedStatusCodes []int, modifiers ...RequestModifier) (Response, error) {
-	req, err := c.NewRequest(method, url)
+	return callWithEndpoint(ctx, c, "", method, url, output, allowedStatusCodes, modifiers...)
+}
+
+// CallWithEndpoint performs the request on the given endpoint, e.g. on the coordinator which holds the cursor.
+// The request is not sent to another endpoint by the connection wrappers, e.g. it is not hedged.
+// The endpoint is chosen by the connection if the given endpoint is empty.
+func CallWithEndpoint(ctx context.Context, c Connection, endpoint, method, url string, output interface{}, modifiers ...RequestModifier) (Response, error) {
+	return callWithEndpoint(ctx, c, endpoint, method, url, output, []int{}, modifiers...)
+}
+
+func callWithEndpoint(ctx context.Context, c Connection, endpoint, method, url string, output interface{}, allowedStatusCodes []int, modifiers ...RequestModifier) (Response, error) {
+	var req Request
+	var err error
+	if endpoint != "" {
+		req, err = c.NewRequestWithEndpoint(endpoint, method, url)
+	} else {
+		req, err = c.NewRequest(method, url)
+	}
 	if err != nil {
 		return nil, err
 	}
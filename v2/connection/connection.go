@@ -24,6 +24,8 @@ import (
 	"context"
 	"io"
 	"net/http"
+
+	"github.com/arangodb/go-driver/v2/log"
 )
 
 type EncodingCodec interface {
@@ -47,6 +49,10 @@ type ArangoDBConfiguration struct {
 
 	// Compression is used to enable compression between client and server
 	Compression *CompressionConfig
+
+	// Logger is used to log the messages of the connection, with the request ID, the endpoint and the database fields.
+	// The global logger (log.SetLogger) is used if it is nil.
+	Logger log.Log
 }
 
 // CompressionConfig is used to enable compression for the connection
@@ -24,6 +24,7 @@ const (
 	PlainText              = "text/plain"
 	ApplicationOctetStream = "application/octet-stream"
 	ApplicationZip         = "application/zip"
+	ApplicationJavaScript  = "application/javascript"
 
 	ApplicationJSON = "application/json"
 
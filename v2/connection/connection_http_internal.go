@@ -33,7 +33,6 @@ import (
 	"path"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	_ "golang.org/x/net/http2"
 
@@ -166,6 +165,7 @@ func (j *httpConnection) newRequestWithEndpoint(endpoint string, method string,
 		method:   method,
 		url:      u,
 		endpoint: e,
+		pinned:   endpoint != "",
 	}
 
 	return r, nil
@@ -183,7 +183,7 @@ func (j *httpConnection) Do(ctx context.Context, request Request, output interfa
 	defer func(closer io.ReadCloser) {
 		err := dropBodyData(closer)
 		if err != nil {
-			log.Errorf(err, "error closing body")
+			connectionLogger(ctx, j.config).Errorf(err, "error closing body")
 		}
 	}(body)
 
@@ -229,8 +229,8 @@ func (j *httpConnection) Stream(ctx context.Context, request Request) (Response,
 
 // stream performs the HTTP request. It returns HTTP response and body reader to read the data from there.
 func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpResponse, io.ReadCloser, error) {
-	id := uuid.New().String()
-	log.Debugf("(%s) Sending request to %s/%s", id, req.Method(), req.URL())
+	logger := requestLogger(ctx, j.config, req)
+	logger.Debugf("Sending request to %s/%s", req.Method(), req.URL())
 	if v, ok := req.GetHeader(ContentType); !ok || v == "" {
 		req.AddHeader(ContentType, j.contentType)
 	}
@@ -250,7 +250,9 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 		ctx = context.Background()
 	}
 
-	reader := j.bodyReadFunc(j.Decoder(j.contentType), req, j.streamSender)
+	// The body is encoded according to the content type of the request, e.g. a zip bundle is sent as raw bytes.
+	requestContentType, _ := req.GetHeader(ContentType)
+	reader := j.bodyReadFunc(j.Decoder(requestContentType), req, j.streamSender, logger)
 	r, err := req.asRequest(ctx, reader)
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
@@ -259,10 +261,10 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 
 	resp, err := j.client.Do(httpReq)
 	if err != nil {
-		log.Debugf("(%s) Request failed: %s", id, err.Error())
+		logger.Debugf("Request failed: %s", err.Error())
 		return nil, nil, errors.WithStack(err)
 	}
-	log.Debugf("(%s) Response received: %d", id, resp.StatusCode)
+	logger.Debugf("Response received: %d", resp.StatusCode)
 
 	if b := resp.Body; b != nil {
 		var resultBody io.ReadCloser
@@ -292,7 +294,7 @@ func getDecoderByContentType(contentType string) Decoder {
 		return getVPackDecoder()
 	case ApplicationJSON:
 		return getJsonDecoder()
-	case PlainText, ApplicationOctetStream, ApplicationZip:
+	case PlainText, ApplicationOctetStream, ApplicationZip, ApplicationJavaScript:
 		return getBytesDecoder()
 	default:
 		return nil
@@ -301,7 +303,7 @@ func getDecoderByContentType(contentType string) Decoder {
 
 type bodyReadFactory func() (io.Reader, error)
 
-func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream bool) bodyReadFactory {
+func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream bool, logger log.Log) bodyReadFactory {
 	if req.body == nil {
 		return func() (io.Reader, error) {
 			return nil, nil
@@ -313,7 +315,7 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 			b := bytes.NewBuffer([]byte{})
 			compressedWriter, err := newCompression(j.config.Compression).ApplyRequestCompression(req, b)
 			if err != nil {
-				log.Errorf(err, "error applying compression")
+				logger.Errorf(err, "error applying compression")
 				return nil, err
 			}
 
@@ -321,7 +323,7 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 				defer func(compressedWriter io.WriteCloser) {
 					errCompression := compressedWriter.Close()
 					if errCompression != nil {
-						log.Error(errCompression, "error closing compressed writer")
+						logger.Error(errCompression, "error closing compressed writer")
 						if err == nil {
 							err = errCompression
 						}
@@ -334,7 +336,7 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 			}
 
 			if err != nil {
-				log.Errorf(err, "error encoding body - OBJ: %v", req.body)
+				logger.Errorf(err, "error encoding body - OBJ: %v", req.body)
 				return nil, err
 			}
 			return b, err
@@ -345,7 +347,7 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 
 			compressedWriter, err := newCompression(j.config.Compression).ApplyRequestCompression(req, writer)
 			if err != nil {
-				log.Errorf(err, "error applying compression")
+				logger.Errorf(err, "error applying compression")
 				return nil, err
 			}
 
@@ -357,7 +359,7 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 					defer func(compressedWriter io.WriteCloser) {
 						errCompression := compressedWriter.Close()
 						if errCompression != nil {
-							log.Errorf(errCompression, "error closing compressed writer - stream")
+							logger.Errorf(errCompression, "error closing compressed writer - stream")
 							writer.CloseWithError(err)
 						}
 					}(compressedWriter)
@@ -368,7 +370,7 @@ func (j *httpConnection) bodyReadFunc(decoder Decoder, req *httpRequest, stream
 				}
 
 				if encErr != nil {
-					log.Errorf(err, "error encoding body stream - OBJ: %v", req.body)
+					logger.Errorf(err, "error encoding body stream - OBJ: %v", req.body)
 					writer.CloseWithError(err)
 				}
 			}()
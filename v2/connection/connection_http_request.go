@@ -25,6 +25,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"path"
+	"strings"
 )
 
 var _ Request = &httpRequest{}
@@ -36,6 +38,9 @@ type httpRequest struct {
 
 	endpoint string
 
+	// pinned is true if the endpoint has been requested explicitly, so the request must not be sent to another endpoint.
+	pinned bool
+
 	body interface{}
 
 	headers map[string]string
@@ -85,6 +90,11 @@ func (j *httpRequest) SetBody(i interface{}) error {
 	return nil
 }
 
+// GetBody returns the body of the request which has been set with SetBody.
+func (j *httpRequest) GetBody() interface{} {
+	return j.body
+}
+
 func (j *httpRequest) Method() string {
 	return j.method
 }
@@ -122,3 +132,29 @@ func (j *httpRequest) asRequest(ctx context.Context, bodyReader bodyReadFactory)
 
 	return r, nil
 }
+
+// withEndpoint returns the copy of the request which is sent to the given endpoint.
+func (j *httpRequest) withEndpoint(endpoint string) (*httpRequest, error) {
+	previous, err := url.Parse(j.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(u.Path, strings.TrimPrefix(j.url.Path, previous.Path))
+	u.RawQuery = j.url.RawQuery
+	u.Fragment = j.url.Fragment
+
+	r := *j
+	r.url = u
+	r.endpoint = endpoint
+	r.headers = make(map[string]string, len(j.headers))
+	for k, v := range j.headers {
+		r.headers[k] = v
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+// connectionLogger returns the logger of the request with the given context.
+// The logger set in the context with log.WithLogger takes precedence over the logger of the connection configuration,
+// and the global logger is used if none of them is set.
+func connectionLogger(ctx context.Context, config ArangoDBConfiguration) log.Log {
+	if l := log.FromContext(ctx); l != nil {
+		return l
+	}
+	return log.OrGlobal(config.Logger)
+}
+
+// requestLogger returns the logger of the request with the structured fields: the request ID, the endpoint and the database.
+// The request ID is taken from the context set with WithRequestID, or it is generated.
+// The fields are not built if no logger is set, because the messages are dropped anyway.
+func requestLogger(ctx context.Context, config ArangoDBConfiguration, req Request) log.Log {
+	l := log.FromContext(ctx)
+	if l == nil {
+		l = config.Logger
+	}
+	if l == nil && !log.IsGlobalSet() {
+		return log.OrGlobal(nil)
+	}
+
+	id, ok := HasRequestID(ctx)
+	if !ok || id == "" {
+		id = uuid.New().String()
+	}
+
+	return log.With(l,
+		"request_id", id,
+		"endpoint", req.Endpoint(),
+		"database", requestDatabase(req))
+}
+
+// requestDatabase returns the database of the request, which is taken from the `/_db/<name>/` prefix of the request path.
+// The `_system` database is returned if there is no prefix.
+func requestDatabase(r Request) string {
+	u, err := url.Parse(r.URL())
+	if err != nil {
+		return "_system"
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "_db" && parts[i+1] != "" {
+			return parts[i+1]
+		}
+	}
+
+	return "_system"
+}
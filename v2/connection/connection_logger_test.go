@@ -0,0 +1,122 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+func newLoggedTestConnection(t *testing.T, logger log.Log) Connection {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHttpConnection(HttpConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.URL}),
+		ContentType:    ApplicationJSON,
+		ArangoDBConfig: ArangoDBConfiguration{Logger: logger},
+	})
+}
+
+func slogTestLogger(buffer *bytes.Buffer, name string) log.Log {
+	return log.With(log.NewSlogLogger(slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))), "client", name)
+}
+
+func decodeLogLines(t *testing.T, data []byte) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		var fields map[string]interface{}
+		require.NoError(t, json.Unmarshal(line, &fields))
+		result = append(result, fields)
+	}
+	return result
+}
+
+func Test_ConnectionLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	c := newLoggedTestConnection(t, slogTestLogger(&buffer, "first"))
+
+	ctx := WithRequestID(context.Background(), "my-id")
+	_, err := CallGet(ctx, c, NewUrl("_db", "test", "_api", "version"), nil)
+	require.NoError(t, err)
+
+	lines := decodeLogLines(t, buffer.Bytes())
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		assert.Equal(t, "first", line["client"])
+		assert.Equal(t, "my-id", line["request_id"])
+		assert.Equal(t, "test", line["database"])
+		assert.NotEmpty(t, line["endpoint"])
+	}
+	assert.Equal(t, "Response received: 200", lines[1]["msg"])
+}
+
+func Test_ContextLoggerWrapper(t *testing.T) {
+	var connectionBuffer, clientBuffer, requestBuffer bytes.Buffer
+	c := newLoggedTestConnection(t, slogTestLogger(&connectionBuffer, "connection"))
+	wrapped := NewContextLoggerWrapper(slogTestLogger(&clientBuffer, "client"))(c)
+
+	_, err := CallGet(context.Background(), wrapped, NewUrl("_api", "version"), nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, connectionBuffer.String())
+	lines := decodeLogLines(t, clientBuffer.Bytes())
+	require.Len(t, lines, 2)
+	assert.Equal(t, "client", lines[0]["client"])
+	assert.Equal(t, "_system", lines[0]["database"])
+	assert.NotEmpty(t, lines[0]["request_id"])
+
+	ctx := log.WithLogger(context.Background(), slogTestLogger(&requestBuffer, "request"))
+	_, err = CallGet(ctx, wrapped, NewUrl("_api", "version"), nil)
+	require.NoError(t, err)
+
+	assert.Len(t, decodeLogLines(t, clientBuffer.Bytes()), 2, "logger of the context takes precedence")
+	assert.Len(t, decodeLogLines(t, requestBuffer.Bytes()), 2)
+}
+
+func Test_RequestLogger_NoLogger(t *testing.T) {
+	req, err := newStubConnection().NewRequest(http.MethodGet, "_db", "test", "_api", "version")
+	require.NoError(t, err)
+
+	// The fields are not built when the messages are dropped.
+	assert.Equal(t, log.OrGlobal(nil), requestLogger(context.Background(), ArangoDBConfiguration{}, req))
+
+	var buffer bytes.Buffer
+	l := requestLogger(WithRequestID(context.Background(), "my-id"), ArangoDBConfiguration{Logger: slogTestLogger(&buffer, "first")}, req)
+	l.Info("message")
+
+	lines := decodeLogLines(t, buffer.Bytes())
+	require.Len(t, lines, 1)
+	assert.Equal(t, "my-id", lines[0]["request_id"])
+	assert.Equal(t, "test", lines[0]["database"])
+}
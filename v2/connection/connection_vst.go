@@ -0,0 +1,71 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"crypto/tls"
+
+	"github.com/arangodb/go-driver/v2/connection/vst/protocol"
+)
+
+// VSTConfiguration is the configuration of the connection which uses the VelocyStream 1.1 protocol.
+type VSTConfiguration struct {
+	// Authentication is applied once per socket connection, right after the connection is opened.
+	// Only the basic authentication and the JWT authentication (`Authorization: bearer <token>`) are supported.
+	Authentication Authentication
+	Endpoint       Endpoint
+
+	// ContentType of the request and response bodies. The default is ApplicationVPack.
+	ContentType string
+
+	// ArangoDBConfig is the configuration of the requests. The compression is not supported by the VST connection.
+	ArangoDBConfig ArangoDBConfiguration
+
+	// TLSConfig is used for the endpoints with the `https` or `ssl` scheme.
+	TLSConfig *tls.Config
+
+	// Transport configures the pool of socket connections per endpoint.
+	Transport protocol.TransportConfig
+}
+
+func (v VSTConfiguration) GetContentType() string {
+	if v.ContentType == "" {
+		return ApplicationVPack
+	}
+
+	return v.ContentType
+}
+
+// NewVSTConnection creates the connection which sends the requests using the VelocyStream 1.1 protocol.
+// Many requests are multiplexed over a small number of socket connections per endpoint.
+//
+// The response message is assembled from its chunks before it is returned, so the body returned by Stream
+// is held in memory as a whole, unlike the body of the HTTP connections.
+// The query parameter can have only one value, and the request with many values of the parameter fails.
+func NewVSTConnection(config VSTConfiguration) Connection {
+	c := newVSTConnection(config.Endpoint, config.GetContentType(), config.TLSConfig, config.Transport, config.ArangoDBConfig)
+
+	if a := config.Authentication; a != nil {
+		c.authentication = a
+	}
+
+	return c
+}
@@ -0,0 +1,362 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/connection/vst/protocol"
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+func newVSTConnection(endpoint Endpoint, contentType string, tlsConfig *tls.Config, transportConfig protocol.TransportConfig,
+	config ArangoDBConfiguration) *vstConnection {
+	return &vstConnection{
+		endpoint:        endpoint,
+		contentType:     contentType,
+		tlsConfig:       tlsConfig,
+		transportConfig: transportConfig,
+		config:          config,
+		transports:      map[string]*protocol.Transport{},
+	}
+}
+
+type vstConnection struct {
+	endpoint    Endpoint
+	contentType string
+
+	tlsConfig       *tls.Config
+	transportConfig protocol.TransportConfig
+
+	config ArangoDBConfiguration
+
+	// lock protects the authentication and the transports.
+	lock           sync.Mutex
+	authentication Authentication
+	transports     map[string]*protocol.Transport
+}
+
+func (v *vstConnection) GetAuthentication() Authentication {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	return v.authentication
+}
+
+// SetAuthentication changes the authentication of the connection.
+// The VST protocol authenticates the socket connections, so all open socket connections are closed.
+func (v *vstConnection) SetAuthentication(a Authentication) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.authentication = a
+	for _, t := range v.transports {
+		t.CloseAllConnections()
+	}
+
+	return nil
+}
+
+// Decoder returns the decoder according to the response content type or VST connection request content type.
+// If the content type is unknown, then it returns default VPack decoder.
+func (v *vstConnection) Decoder(contentType string) Decoder {
+	if decoder := getDecoderByContentType(contentType); decoder != nil {
+		return decoder
+	}
+
+	if decoder := getDecoderByContentType(v.contentType); decoder != nil {
+		return decoder
+	}
+
+	return getVPackDecoder()
+}
+
+func (v *vstConnection) GetEndpoint() Endpoint {
+	return v.endpoint
+}
+
+func (v *vstConnection) SetEndpoint(e Endpoint) error {
+	v.endpoint = e
+	return nil
+}
+
+func (v *vstConnection) GetConfiguration() ArangoDBConfiguration {
+	return v.config
+}
+
+func (v *vstConnection) SetConfiguration(config ArangoDBConfiguration) {
+	v.config = config
+}
+
+func (v *vstConnection) NewRequestWithEndpoint(endpoint string, method string, urlParts ...string) (Request, error) {
+	return v.newRequestWithEndpoint(endpoint, method, urlParts...)
+}
+
+func (v *vstConnection) NewRequest(method string, urlParts ...string) (Request, error) {
+	return v.newRequestWithEndpoint("", method, urlParts...)
+}
+
+func (v *vstConnection) newRequestWithEndpoint(endpoint string, method string, urlParts ...string) (*vstRequest, error) {
+	urlPath := path.Join(urlParts...)
+
+	e, err := v.endpoint.Get(endpoint, method, urlPath)
+	if err != nil {
+		return nil, errors.Errorf("Unable to resolve endpoint for %s", endpoint)
+	}
+
+	return &vstRequest{
+		method:   method,
+		path:     strings.TrimPrefix(urlPath, "/"),
+		endpoint: e,
+	}, nil
+}
+
+// Do performs the VST request and returns the response.
+// If `output` is provided, then it is populated from response body.
+func (v *vstConnection) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	resp, body, err := v.Stream(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+
+	return DecodeStream(v.Decoder(resp.Content()), resp, body, output, allowedStatusCodes...)
+}
+
+// Stream performs the VST request.
+// The response message is received as a whole, so the returned reader reads the body from memory.
+func (v *vstConnection) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	req, ok := request.(*vstRequest)
+	if !ok {
+		return nil, nil, errors.Errorf("unable to parse request into VST Request")
+	}
+
+	resp, body, err := v.stream(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, io.NopCloser(bytes.NewReader(body)), nil
+}
+
+func (v *vstConnection) stream(ctx context.Context, req *vstRequest) (*vstResponse, []byte, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	logger := requestLogger(ctx, v.config, req)
+	logger.Debugf("Sending VST request to %s/%s", req.Method(), req.URL())
+
+	if h, ok := req.GetHeader(ContentType); !ok || h == "" {
+		req.AddHeader(ContentType, v.contentType)
+	}
+	if h, ok := req.GetHeader("Accept"); !ok || h == "" {
+		req.AddHeader("Accept", v.contentType)
+	}
+
+	parts, err := v.messageParts(req, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transport, err := v.getTransport(req.Endpoint())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, body, err := sendVSTMessage(ctx, transport.Send, req.Endpoint(), parts...)
+	if err != nil {
+		logger.Debugf("Request failed: %s", err.Error())
+		return nil, nil, err
+	}
+	logger.Debugf("Response received: %d", resp.Code())
+
+	return resp, body, nil
+}
+
+// messageParts returns the header and the body of the request message.
+// The body is encoded according to the content type of the request, e.g. a zip bundle is sent as raw bytes.
+func (v *vstConnection) messageParts(req *vstRequest, logger log.Log) ([][]byte, error) {
+	hdr, err := req.header()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.body == nil {
+		return [][]byte{hdr}, nil
+	}
+
+	requestContentType, _ := req.GetHeader(ContentType)
+
+	var body bytes.Buffer
+	if err := v.Decoder(requestContentType).Encode(&body, req.body); err != nil {
+		logger.Errorf(err, "error encoding body - OBJ: %v", req.body)
+		return nil, errors.WithStack(err)
+	}
+
+	return [][]byte{hdr, body.Bytes()}, nil
+}
+
+// getTransport returns the pool of socket connections for the given endpoint.
+func (v *vstConnection) getTransport(endpoint string) (*protocol.Transport, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if t, ok := v.transports[endpoint]; ok {
+		return t, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var tlsConfig *tls.Config
+	switch strings.ToLower(u.Scheme) {
+	case "https", "ssl":
+		tlsConfig = v.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+	case "http", "tcp":
+	default:
+		return nil, errors.Errorf("scheme %s is not supported by the VST connection", u.Scheme)
+	}
+
+	t := protocol.NewTransport(u.Host, tlsConfig, v.transportConfig)
+	t.SetOnConnectionCreated(v.authenticate)
+	v.transports[endpoint] = t
+
+	return t, nil
+}
+
+// authenticate sends the authentication message over the new socket connection.
+func (v *vstConnection) authenticate(ctx context.Context, conn *protocol.Connection) error {
+	message, err := newVSTAuthenticationMessage(v.GetAuthentication())
+	if err != nil || message == nil {
+		return err
+	}
+
+	resp, _, err := sendVSTMessage(ctx, conn.Send, "", message)
+	if err != nil {
+		return err
+	}
+
+	if resp.Code() != http.StatusOK {
+		return NewError(resp.Code(), "VST authentication failed")
+	}
+
+	return nil
+}
+
+// sendVSTMessage sends the message and waits for the response.
+func sendVSTMessage(ctx context.Context, send func(context.Context, ...[]byte) (<-chan protocol.Message, error),
+	endpoint string, parts ...[]byte) (*vstResponse, []byte, error) {
+	responses, err := send(ctx, parts...)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	select {
+	case m, ok := <-responses:
+		if !ok {
+			return nil, nil, errors.Errorf("connection closed before the response was received")
+		}
+
+		return newVSTResponse(m.Data, endpoint)
+	case <-ctx.Done():
+		return nil, nil, errors.WithStack(ctx.Err())
+	}
+}
+
+// newVSTAuthenticationMessage converts the authentication into the VST authentication message.
+// The authentication header is built by the given authentication, and it is translated into
+// [version, type, "plain", user, password] or [version, type, "jwt", token] message.
+// It returns nil if there is no authentication.
+func newVSTAuthenticationMessage(a Authentication) (velocypack.Slice, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	r := &vstRequest{}
+	if err := a.RequestModifier(r); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	value, ok := r.GetHeader("Authorization")
+	if !ok {
+		return nil, errors.Errorf("only the authentication with the Authorization header is supported by the VST connection")
+	}
+
+	scheme, credentials, _ := strings.Cut(value, " ")
+
+	values := []velocypack.Value{
+		velocypack.NewIntValue(1),    // version of the protocol
+		velocypack.NewIntValue(1000), // type of the message: authentication
+	}
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		user, password, _ := strings.Cut(string(decoded), ":")
+		values = append(values, velocypack.NewStringValue("plain"), velocypack.NewStringValue(user),
+			velocypack.NewStringValue(password))
+	case "bearer":
+		values = append(values, velocypack.NewStringValue("jwt"), velocypack.NewStringValue(credentials))
+	default:
+		return nil, errors.Errorf("authentication scheme %s is not supported by the VST connection", scheme)
+	}
+
+	var b velocypack.Builder
+	if err := b.OpenArray(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, value := range values {
+		if err := b.AddValue(value); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if err := b.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	slice, err := b.Slice()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return slice, nil
+}
@@ -0,0 +1,210 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/pkg/errors"
+)
+
+var _ Request = &vstRequest{}
+
+type vstRequest struct {
+	method string
+
+	// path is the path of the request, e.g. `_db/name/_api/version`.
+	path string
+
+	query url.Values
+
+	fragment string
+
+	endpoint string
+
+	body interface{}
+
+	headers map[string]string
+}
+
+func (v *vstRequest) Method() string {
+	return v.method
+}
+
+func (v *vstRequest) URL() string {
+	u := strings.TrimSuffix(v.endpoint, "/") + "/" + v.path
+	if len(v.query) > 0 {
+		u += "?" + v.query.Encode()
+	}
+	return u
+}
+
+func (v *vstRequest) Endpoint() string {
+	return v.endpoint
+}
+
+func (v *vstRequest) SetBody(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+
+	v.body = i
+
+	return nil
+}
+
+// GetBody returns the body of the request which has been set with SetBody.
+func (v *vstRequest) GetBody() interface{} {
+	return v.body
+}
+
+func (v *vstRequest) AddHeader(key, value string) {
+	if v.headers == nil {
+		v.headers = map[string]string{}
+	}
+
+	v.headers[key] = value
+}
+
+func (v *vstRequest) AddQuery(key, value string) {
+	if v.query == nil {
+		v.query = url.Values{}
+	}
+
+	v.query.Add(key, value)
+}
+
+func (v *vstRequest) GetHeader(key string) (string, bool) {
+	k, ok := v.headers[key]
+	return k, ok
+}
+
+func (v *vstRequest) GetQuery(key string) (string, bool) {
+	q := v.query.Get(key)
+	return q, q != ""
+}
+
+// SetFragment stores the fragment of the URL. It is not sent by the VST protocol.
+func (v *vstRequest) SetFragment(s string) {
+	v.fragment = s
+}
+
+// vstRequestTypes maps the HTTP methods to the request types of the VST protocol.
+var vstRequestTypes = map[string]int64{
+	http.MethodDelete:  0,
+	http.MethodGet:     1,
+	http.MethodPost:    2,
+	http.MethodPut:     3,
+	http.MethodHead:    4,
+	http.MethodPatch:   5,
+	http.MethodOptions: 6,
+}
+
+// header builds the VelocyPack header of the request message:
+// [version, type, database, requestType, path, parameters, meta].
+func (v *vstRequest) header() (velocypack.Slice, error) {
+	requestType, ok := vstRequestTypes[v.method]
+	if !ok {
+		return nil, errors.Errorf("method %s is not supported by the VST protocol", v.method)
+	}
+
+	// The database name is sent separately, so it is removed from the path.
+	database := "_system"
+	path := strings.TrimPrefix(v.path, "/")
+	if strings.HasPrefix(path, "_db/") {
+		parts := strings.SplitN(strings.TrimPrefix(path, "_db/"), "/", 2)
+
+		name, err := url.PathUnescape(parts[0])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		database = name
+
+		path = ""
+		if len(parts) > 1 {
+			path = parts[1]
+		}
+	}
+
+	var b velocypack.Builder
+	if err := b.OpenArray(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	values := []velocypack.Value{
+		velocypack.NewIntValue(1), // version of the protocol
+		velocypack.NewIntValue(1), // type of the message: request
+		velocypack.NewStringValue(database),
+		velocypack.NewIntValue(requestType),
+		velocypack.NewStringValue("/" + path),
+	}
+	for _, value := range values {
+		if err := b.AddValue(value); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	// The VST message holds a single value per query parameter, so the parameters with many values are rejected.
+	parameters := make(map[string]string, len(v.query))
+	for k, values := range v.query {
+		switch len(values) {
+		case 0:
+		case 1:
+			parameters[k] = values[0]
+		default:
+			return nil, errors.Errorf("query parameter %s has %d values, but only one value is supported by VST", k, len(values))
+		}
+	}
+
+	for _, object := range []map[string]string{parameters, v.headers} {
+		if err := addVSTObject(&b, object); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := b.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	slice, err := b.Slice()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return slice, nil
+}
+
+func addVSTObject(b *velocypack.Builder, object map[string]string) error {
+	if err := b.OpenObject(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for k, v := range object {
+		if err := b.AddKeyValue(k, velocypack.NewStringValue(v)); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(b.Close())
+}
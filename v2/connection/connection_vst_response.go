@@ -0,0 +1,162 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/pkg/errors"
+)
+
+var _ Response = &vstResponse{}
+
+type vstResponse struct {
+	endpoint string
+	code     int
+
+	// headers contains the meta fields of the response with the lowercase keys.
+	headers map[string]string
+
+	message []byte
+}
+
+// newVSTResponse parses the VelocyPack header of the response message: [version, type, responseCode, meta].
+// It returns the response and the body which follows the header.
+func newVSTResponse(message []byte, endpoint string) (*vstResponse, []byte, error) {
+	hdr := velocypack.Slice(message)
+	if err := hdr.AssertType(velocypack.Array); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	length, err := hdr.Length()
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	if length < 3 {
+		return nil, nil, errors.Errorf("expected a header of at least 3 elements, got %d", length)
+	}
+
+	code, err := hdr.At(2)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	r := &vstResponse{
+		endpoint: endpoint,
+		headers:  map[string]string{},
+		message:  message,
+	}
+
+	if c, err := code.GetInt(); err != nil {
+		return nil, nil, errors.WithStack(err)
+	} else {
+		r.code = int(c)
+	}
+
+	if length >= 4 {
+		meta, err := hdr.At(3)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		if err := r.parseMeta(meta); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	size, err := hdr.ByteSize()
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return r, message[size:], nil
+}
+
+func (v *vstResponse) parseMeta(meta velocypack.Slice) error {
+	if !meta.IsObject() {
+		return errors.Errorf("expected meta field to be of type Object, got %s", meta.Type())
+	}
+
+	count, err := meta.Length()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := velocypack.ValueLength(0); i < count; i++ {
+		key, err := meta.KeyAt(i)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		value, err := meta.ValueAt(i)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		k, err := key.GetString()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if !value.IsString() {
+			// Only the string values can be returned as headers.
+			continue
+		}
+
+		s, err := value.GetString()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		v.headers[strings.ToLower(k)] = s
+	}
+
+	return nil
+}
+
+func (v *vstResponse) Code() int {
+	return v.code
+}
+
+// Response returns the whole VST message of the response.
+func (v *vstResponse) Response() interface{} {
+	return v.message
+}
+
+func (v *vstResponse) Endpoint() string {
+	return v.endpoint
+}
+
+func (v *vstResponse) Content() string {
+	// The header can be returned with arguments, e.g.: "Content-Type: text/html; charset=UTF-8".
+	return strings.TrimSpace(strings.Split(v.Header(ContentType), ";")[0])
+}
+
+func (v *vstResponse) Header(name string) string {
+	return v.headers[strings.ToLower(name)]
+}
+
+// RawResponse returns nil, because the response is not sent over HTTP.
+func (v *vstResponse) RawResponse() *http.Response {
+	return nil
+}
@@ -0,0 +1,353 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// vstServerRequest is the request received by the vstServer.
+type vstServerRequest struct {
+	messageType int64
+	database    string
+	method      int64
+	path        string
+	query       map[string]string
+	headers     map[string]string
+	body        []byte
+
+	// authentication contains the string values of the authentication message.
+	authentication []string
+}
+
+// vstServer is the VST 1.1 server which handles the requests with the given handler.
+type vstServer struct {
+	listener net.Listener
+	handler  func(r vstServerRequest) (int, map[string]string, []byte)
+
+	lock     sync.Mutex
+	requests []vstServerRequest
+}
+
+func newVSTServer(t *testing.T, handler func(r vstServerRequest) (int, map[string]string, []byte)) *vstServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &vstServer{listener: listener, handler: handler}
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(t, conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *vstServer) endpoint() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+func (s *vstServer) received() []vstServerRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]vstServerRequest{}, s.requests...)
+}
+
+func (s *vstServer) serve(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+
+	header := make([]byte, len("VST/1.1\r\n\r\n"))
+	if _, err := io.ReadFull(conn, header); err != nil || string(header) != "VST/1.1\r\n\r\n" {
+		return
+	}
+
+	// The chunks of each message are sent in order, but the messages may interleave.
+	parts := map[uint64][]byte{}
+	for {
+		hdr := make([]byte, 24)
+		if _, err := io.ReadFull(conn, hdr); err != nil {
+			return
+		}
+		data := make([]byte, binary.LittleEndian.Uint32(hdr[0:])-24)
+		if _, err := io.ReadFull(conn, data); err != nil {
+			return
+		}
+
+		id := binary.LittleEndian.Uint64(hdr[8:])
+		parts[id] = append(parts[id], data...)
+		if uint64(len(parts[id])) < binary.LittleEndian.Uint64(hdr[16:]) {
+			continue
+		}
+
+		message := parts[id]
+		delete(parts, id)
+
+		request := parseVSTServerRequest(t, message)
+		s.lock.Lock()
+		s.requests = append(s.requests, request)
+		s.lock.Unlock()
+
+		code, meta, body := http.StatusOK, map[string]string{}, []byte(nil)
+		if request.messageType != 1000 {
+			code, meta, body = s.handler(request)
+		}
+
+		var b velocypack.Builder
+		require.NoError(t, b.OpenArray())
+		require.NoError(t, b.AddValue(velocypack.NewIntValue(1)))
+		require.NoError(t, b.AddValue(velocypack.NewIntValue(2)))
+		require.NoError(t, b.AddValue(velocypack.NewIntValue(int64(code))))
+		require.NoError(t, addVSTObject(&b, meta))
+		require.NoError(t, b.Close())
+		response, err := b.Bytes()
+		require.NoError(t, err)
+		response = append(response, body...)
+
+		out := make([]byte, 24)
+		binary.LittleEndian.PutUint32(out[0:], uint32(24+len(response)))
+		binary.LittleEndian.PutUint32(out[4:], 3)
+		binary.LittleEndian.PutUint64(out[8:], id)
+		binary.LittleEndian.PutUint64(out[16:], uint64(len(response)))
+		if _, err := conn.Write(append(out, response...)); err != nil {
+			return
+		}
+	}
+}
+
+func parseVSTServerRequest(t *testing.T, message []byte) vstServerRequest {
+	hdr := velocypack.Slice(message)
+
+	at := func(i velocypack.ValueLength) velocypack.Slice {
+		s, err := hdr.At(i)
+		require.NoError(t, err)
+		return s
+	}
+	str := func(s velocypack.Slice) string {
+		v, err := s.GetString()
+		require.NoError(t, err)
+		return v
+	}
+	integer := func(s velocypack.Slice) int64 {
+		v, err := s.GetInt()
+		require.NoError(t, err)
+		return v
+	}
+	object := func(s velocypack.Slice) map[string]string {
+		result := map[string]string{}
+		it, err := velocypack.NewObjectIterator(s)
+		require.NoError(t, err)
+		for it.IsValid() {
+			k, err := it.Key(true)
+			require.NoError(t, err)
+			v, err := it.Value()
+			require.NoError(t, err)
+			result[str(k)] = str(v)
+			require.NoError(t, it.Next())
+		}
+		return result
+	}
+
+	r := vstServerRequest{messageType: integer(at(1))}
+	length, err := hdr.Length()
+	require.NoError(t, err)
+
+	if r.messageType == 1000 {
+		for i := velocypack.ValueLength(2); i < length; i++ {
+			r.authentication = append(r.authentication, str(at(i)))
+		}
+		return r
+	}
+
+	r.database = str(at(2))
+	r.method = integer(at(3))
+	r.path = str(at(4))
+	r.query = object(at(5))
+	r.headers = object(at(6))
+
+	size, err := hdr.ByteSize()
+	require.NoError(t, err)
+	r.body = message[size:]
+
+	return r
+}
+
+func Test_VSTConnection(t *testing.T) {
+	server := newVSTServer(t, func(r vstServerRequest) (int, map[string]string, []byte) {
+		switch r.path {
+		case "/_api/version":
+			body, err := velocypack.Marshal(map[string]string{"version": "3.12.0"})
+			require.NoError(t, err)
+			return http.StatusOK, map[string]string{"Content-Type": ApplicationVPack}, body
+		case "/_api/echo":
+			return http.StatusOK, map[string]string{"Content-Type": ApplicationJSON}, r.body
+		default:
+			return http.StatusNotFound, map[string]string{"Content-Type": ApplicationJSON},
+				[]byte(`{"error":true,"code":404,"errorNum":1203,"errorMessage":"not found"}`)
+		}
+	})
+
+	c := NewVSTConnection(VSTConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.endpoint()}),
+		Authentication: NewBasicAuth("root", "secret"),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var version struct {
+		Version string `json:"version"`
+	}
+	resp, err := CallGet(ctx, c, NewUrl("_db", url.PathEscape("my db"), "_api", "version"), &version,
+		WithQuery("details", "true"), WithHeader("x-test", "value"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code())
+	require.Equal(t, ApplicationVPack, resp.Content())
+	require.Equal(t, "3.12.0", version.Version)
+
+	requests := server.received()
+	require.Len(t, requests, 2)
+	require.Equal(t, []string{"plain", "root", "secret"}, requests[0].authentication)
+	require.Equal(t, "my db", requests[1].database)
+	require.Equal(t, int64(1), requests[1].method)
+	require.Equal(t, "/_api/version", requests[1].path)
+	require.Equal(t, "true", requests[1].query["details"])
+	require.Equal(t, "value", requests[1].headers["x-test"])
+
+	t.Run("JSON body", func(t *testing.T) {
+		var out map[string]int
+		req, err := c.NewRequest(http.MethodPost, "_api", "echo")
+		require.NoError(t, err)
+		req.AddHeader(ContentType, ApplicationJSON)
+		require.NoError(t, req.SetBody(map[string]int{"a": 1}))
+
+		_, err = c.Do(ctx, req, &out, http.StatusOK)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"a": 1}, out)
+
+		requests := server.received()
+		require.Equal(t, "_system", requests[len(requests)-1].database)
+		require.Equal(t, int64(2), requests[len(requests)-1].method)
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		req, err := c.NewRequest(http.MethodGet, "_api", "missing")
+		require.NoError(t, err)
+
+		resp, err := c.Do(ctx, req, nil, http.StatusOK)
+		require.True(t, shared.IsNotFound(err))
+		require.Equal(t, http.StatusNotFound, resp.Code())
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		req, err := c.NewRequest(http.MethodPost, "_api", "echo")
+		require.NoError(t, err)
+		req.AddHeader(ContentType, ApplicationOctetStream)
+		require.NoError(t, req.SetBody([]byte("raw data")))
+
+		resp, body, err := c.Stream(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.Code())
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+		require.Equal(t, "raw data", string(data))
+	})
+
+	t.Run("JWT authentication", func(t *testing.T) {
+		require.NoError(t, c.SetAuthentication(NewHeaderAuth("Authorization", "bearer %s", "token")))
+
+		_, err := CallGet(ctx, c, NewUrl("_api", "version"), nil)
+		require.NoError(t, err)
+
+		var found bool
+		for _, r := range server.received() {
+			if len(r.authentication) > 0 && r.authentication[0] == "jwt" {
+				require.Equal(t, []string{"jwt", "token"}, r.authentication)
+				found = true
+			}
+		}
+		require.True(t, found)
+	})
+}
+
+func Test_VSTAuthenticationMessage(t *testing.T) {
+	message, err := newVSTAuthenticationMessage(nil)
+	require.NoError(t, err)
+	require.Nil(t, message)
+
+	message, err = newVSTAuthenticationMessage(NewBasicAuth("user", "pass:word"))
+	require.NoError(t, err)
+	r := parseVSTServerRequest(t, message)
+	require.Equal(t, int64(1000), r.messageType)
+	require.Equal(t, []string{"plain", "user", "pass:word"}, r.authentication)
+
+	_, err = newVSTAuthenticationMessage(NewHeaderAuth("x-custom", "value"))
+	require.Error(t, err)
+	_, err = newVSTAuthenticationMessage(NewHeaderAuth("Authorization", "Digest value"))
+	require.Error(t, err)
+}
+
+func Test_VSTRequestHeader(t *testing.T) {
+	r := &vstRequest{method: http.MethodPatch, path: "_db/db%2Fname"}
+	hdr, err := r.header()
+	require.NoError(t, err)
+
+	parsed := parseVSTServerRequest(t, append(hdr, 0x18))
+	require.Equal(t, "db/name", parsed.database)
+	require.Equal(t, int64(5), parsed.method)
+	require.Equal(t, "/", parsed.path)
+	require.Equal(t, []byte{0x18}, parsed.body)
+
+	_, err = (&vstRequest{method: "TRACE", path: "_api"}).header()
+	require.Error(t, err)
+
+	r = &vstRequest{method: http.MethodGet, path: "_api/version"}
+	r.AddQuery("details", "true")
+	_, err = r.header()
+	require.NoError(t, err)
+
+	r.AddQuery("details", "false")
+	_, err = r.header()
+	require.Error(t, err, "many values of the query parameter can not be sent")
+}
@@ -29,6 +29,9 @@ type ContextKey string
 const (
 	keyAsyncRequest ContextKey = "arangodb-async-request"
 	keyAsyncID      ContextKey = "arangodb-async-id"
+	keyIdempotent   ContextKey = "arangodb-idempotent"
+	keyHedgeable    ContextKey = "arangodb-hedgeable"
+	keyRequestID    ContextKey = "arangodb-request-id"
 )
 
 // contextOrBackground returns the given context if it is not nil.
@@ -50,6 +53,24 @@ func WithAsyncID(parent context.Context, asyncID string) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyAsyncID, asyncID)
 }
 
+// WithIdempotent is used to mark the request as idempotent, so it is safe to retry it even if it modifies data.
+// It is respected by the Connection wrapped with NewRetryPolicyWrapper.
+func WithIdempotent(parent context.Context) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyIdempotent, true)
+}
+
+// WithHedgeable is used to mark the read-only request, e.g. the AQL cursor request, as safe to be hedged.
+// GET and HEAD requests are always hedged by the Connection wrapped with NewHedgingWrapper.
+func WithHedgeable(parent context.Context) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyHedgeable, true)
+}
+
+// WithRequestID is used to set the ID of the request, which is logged by the Connection wrapped with NewLoggerWrapper.
+// The ID is generated by the wrapper if it is not set.
+func WithRequestID(parent context.Context, requestID string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyRequestID, requestID)
+}
+
 //
 // READ METHODS
 //
@@ -79,3 +100,42 @@ func HasAsyncID(ctx context.Context) (string, bool) {
 
 	return "", false
 }
+
+// IsIdempotentRequest returns true if the given context is marked with WithIdempotent.
+func IsIdempotentRequest(ctx context.Context) bool {
+	if ctx != nil {
+		if v := ctx.Value(keyIdempotent); v != nil {
+			if isIdempotent, ok := v.(bool); ok && isIdempotent {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsHedgeableRequest returns true if the given context is marked with WithHedgeable.
+func IsHedgeableRequest(ctx context.Context) bool {
+	if ctx != nil {
+		if v := ctx.Value(keyHedgeable); v != nil {
+			if isHedgeable, ok := v.(bool); ok && isHedgeable {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// HasRequestID returns the request ID from the given context.
+func HasRequestID(ctx context.Context) (string, bool) {
+	if ctx != nil {
+		if q := ctx.Value(keyRequestID); q != nil {
+			if v, ok := q.(string); ok {
+				return v, true
+			}
+		}
+	}
+
+	return "", false
+}
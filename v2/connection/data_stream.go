@@ -24,6 +24,10 @@ package connection
 
 import (
 	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func deferCloser(closer io.ReadCloser) error {
@@ -45,3 +49,37 @@ func dropBodyData(closer io.ReadCloser) error {
 		}
 	}
 }
+
+// DecodeStream checks the status code of the streamed response and decodes its body into the output.
+// It is used by the connection wrappers which implement Do on top of Stream, also outside of this package.
+// The body is always freed.
+func DecodeStream(decoder Decoder, resp Response, body io.ReadCloser, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	// The body should be closed at the end of the function.
+	defer dropBodyData(body)
+
+	if len(allowedStatusCodes) > 0 {
+		found := false
+		for _, e := range allowedStatusCodes {
+			if resp.Code() == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			var respStruct shared.Response
+			// try parse as ArangoDB error response
+			_ = decoder.Decode(body, &respStruct)
+			return resp, respStruct.AsArangoErrorWithCode(resp.Code())
+		}
+	}
+
+	if output != nil {
+		if err := decoder.Decode(body, output); err != nil {
+			if err != io.EOF {
+				return resp, errors.WithStack(err)
+			}
+		}
+	}
+
+	return resp, nil
+}
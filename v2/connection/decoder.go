@@ -97,7 +97,7 @@ func getBytesDecoder() Decoder {
 var ErrReaderOutputBytes = errors.New("use *[]byte as output argument")
 
 // ErrWriterInputBytes is the error to inform caller about invalid input argument.
-var ErrWriterInputBytes = errors.New("use []byte as input argument")
+var ErrWriterInputBytes = errors.New("use []byte or io.Reader as input argument")
 
 var bytesDecoderObj Decoder = &bytesDecoder{}
 
@@ -121,10 +121,15 @@ func (j bytesDecoder) Decode(reader io.Reader, obj interface{}) error {
 }
 
 // Encode encodes bytes to the writer.
+// The input can be a slice of bytes or a reader which is copied to the writer.
 func (j bytesDecoder) Encode(writer io.Writer, obj interface{}) error {
-	if bytes, ok := obj.([]byte); ok {
-		writer.Write(bytes)
-		return nil
+	switch input := obj.(type) {
+	case []byte:
+		_, err := writer.Write(input)
+		return err
+	case io.Reader:
+		_, err := io.Copy(writer, input)
+		return err
 	}
 
 	return ErrWriterInputBytes
@@ -59,6 +59,16 @@ func Test_bytesDecoder_Encode(t *testing.T) {
 		assert.Equal(t, request, string(buf.Bytes()))
 	})
 
+	t.Run("send request from reader", func(t *testing.T) {
+		var buf bytes.Buffer
+		request := "the request"
+
+		err := bytesDecoder{}.Encode(&buf, strings.NewReader(request))
+
+		require.NoError(t, err)
+		assert.Equal(t, request, buf.String())
+	})
+
 	t.Run("invalid input argument", func(t *testing.T) {
 		var buf bytes.Buffer
 
@@ -0,0 +1,215 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultDiscoveryRefreshInterval is the default interval between the refreshes of the endpoints.
+	DefaultDiscoveryRefreshInterval = 30 * time.Second
+	// DefaultDiscoveryRefreshTimeout is the default timeout of a single refresh.
+	DefaultDiscoveryRefreshTimeout = 10 * time.Second
+)
+
+// EndpointFactory creates the Endpoint which chooses from the given endpoints.
+type EndpointFactory func(endpoints []string) (Endpoint, error)
+
+// RoundRobinEndpointFactory creates the round-robin Endpoint.
+func RoundRobinEndpointFactory(endpoints []string) (Endpoint, error) {
+	return NewRoundRobinEndpoints(endpoints), nil
+}
+
+// MaglevHashEndpointFactory returns the factory of the Maglev hash Endpoint which uses the given extractor.
+func MaglevHashEndpointFactory(extractor RequestHashValueExtractor) EndpointFactory {
+	return func(endpoints []string) (Endpoint, error) {
+		return NewMaglevHashEndpoints(endpoints, extractor)
+	}
+}
+
+// DiscoveryEndpointOptions configures the endpoint created with NewDiscoveryEndpoints.
+// The zero value of each field means its default value.
+type DiscoveryEndpointOptions struct {
+	// RefreshInterval is the interval between the refreshes of the endpoints.
+	RefreshInterval time.Duration
+
+	// RefreshTimeout is the timeout of a single refresh.
+	RefreshTimeout time.Duration
+
+	// OnMembershipChange is called after the set of endpoints has been swapped.
+	OnMembershipChange func(added, removed []string)
+}
+
+// DiscoveryEndpoint is the Endpoint which keeps the list of the coordinators up to date.
+type DiscoveryEndpoint interface {
+	Endpoint
+
+	// Refresh fetches the endpoints of the coordinators from `/_api/cluster/endpoints` and swaps the set
+	// of endpoints when it has changed. It returns true if the set has been changed.
+	Refresh(ctx context.Context, conn Connection) (bool, error)
+
+	// StartRefreshing refreshes the endpoints periodically in the background, until the context is done.
+	// The failed refreshes are logged with the logger of the context or of the connection configuration.
+	StartRefreshing(ctx context.Context, conn Connection)
+}
+
+// NewDiscoveryEndpoints returns the Endpoint which starts with the seed endpoints and updates them with Refresh.
+// The factory is used to create the Endpoint choosing from the current endpoints, e.g. RoundRobinEndpointFactory.
+// The set of endpoints is swapped atomically, so the requests are never sent to a partially updated set.
+//
+// The connection given to Refresh and StartRefreshing should use the returned Endpoint, so the list is fetched
+// from the coordinators which are currently known.
+func NewDiscoveryEndpoints(seed []string, factory EndpointFactory, opts *DiscoveryEndpointOptions) (DiscoveryEndpoint, error) {
+	e := &discoveryEndpoints{
+		factory: factory,
+	}
+
+	if opts != nil {
+		e.opts = *opts
+	}
+	if e.opts.RefreshInterval <= 0 {
+		e.opts.RefreshInterval = DefaultDiscoveryRefreshInterval
+	}
+	if e.opts.RefreshTimeout <= 0 {
+		e.opts.RefreshTimeout = DefaultDiscoveryRefreshTimeout
+	}
+
+	current, err := factory(append([]string{}, seed...))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e.current.Store(&current)
+
+	return e, nil
+}
+
+type discoveryEndpoints struct {
+	factory EndpointFactory
+	opts    DiscoveryEndpointOptions
+
+	// refreshLock prevents the concurrent refreshes from swapping the endpoints in the wrong order.
+	refreshLock sync.Mutex
+	current     atomic.Pointer[Endpoint]
+}
+
+func (e *discoveryEndpoints) endpoint() Endpoint {
+	return *e.current.Load()
+}
+
+func (e *discoveryEndpoints) Get(providedEp, method, path string) (string, error) {
+	return e.endpoint().Get(providedEp, method, path)
+}
+
+func (e *discoveryEndpoints) List() []string {
+	return e.endpoint().List()
+}
+
+func (e *discoveryEndpoints) Refresh(ctx context.Context, conn Connection) (bool, error) {
+	ctx, cancel := context.WithTimeout(contextOrBackground(ctx), e.opts.RefreshTimeout)
+	defer cancel()
+
+	req, err := conn.NewRequest(http.MethodGet, "_api", "cluster", "endpoints")
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	var response struct {
+		Endpoints []struct {
+			Endpoint string `json:"endpoint"`
+		} `json:"endpoints"`
+	}
+
+	if _, err := conn.Do(ctx, req, &response, http.StatusOK); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	endpoints := make([]string, 0, len(response.Endpoints))
+	for _, ep := range response.Endpoints {
+		endpoints = append(endpoints, FixupEndpointURLScheme(ep.Endpoint))
+	}
+
+	if len(endpoints) == 0 {
+		return false, errors.New("no endpoints returned by the cluster")
+	}
+
+	e.refreshLock.Lock()
+	defer e.refreshLock.Unlock()
+
+	previous := e.List()
+	added, removed := diffEndpoints(previous, endpoints)
+	if len(added) == 0 && len(removed) == 0 {
+		return false, nil
+	}
+
+	next, err := e.factory(endpoints)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	e.current.Store(&next)
+
+	if e.opts.OnMembershipChange != nil {
+		e.opts.OnMembershipChange(added, removed)
+	}
+
+	return true, nil
+}
+
+func (e *discoveryEndpoints) StartRefreshing(ctx context.Context, conn Connection) {
+	go func() {
+		ticker := time.NewTicker(e.opts.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := e.Refresh(ctx, conn); err != nil && ctx.Err() == nil {
+					connectionLogger(ctx, conn.GetConfiguration()).Errorf(err, "unable to refresh the endpoints")
+				}
+			}
+		}
+	}()
+}
+
+// diffEndpoints returns the endpoints which are in the next list and not in the previous one, and vice versa.
+func diffEndpoints(previous, next []string) (added, removed []string) {
+	for _, ep := range next {
+		if !contains(previous, ep) {
+			added = append(added, ep)
+		}
+	}
+
+	for _, ep := range previous {
+		if !contains(next, ep) {
+			removed = append(removed, ep)
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,159 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+// lineWriter sends the written log lines to the channel, and drops them when the channel is full.
+type lineWriter chan string
+
+func (w lineWriter) Write(p []byte) (int, error) {
+	select {
+	case w <- string(p):
+	default:
+	}
+	return len(p), nil
+}
+
+func Test_DiscoveryEndpoints(t *testing.T) {
+	newCluster := func(factory EndpointFactory, opts *DiscoveryEndpointOptions) (DiscoveryEndpoint, *stubConnection, func(...string)) {
+		e, err := NewDiscoveryEndpoints([]string{"http://seed:8529"}, factory, opts)
+		require.NoError(t, err)
+
+		var lock sync.Mutex
+		var coordinators []string
+
+		stub := newStubConnection()
+		stub.endpoint = e
+		stub.handler = func(_ context.Context, _ int, request Request) stubResponse {
+			lock.Lock()
+			defer lock.Unlock()
+
+			assert.True(t, strings.HasSuffix(request.URL(), ":8529/_api/cluster/endpoints"), request.URL())
+			items := make([]string, 0, len(coordinators))
+			for _, c := range coordinators {
+				items = append(items, `{"endpoint":"`+c+`"}`)
+			}
+			return stubResponse{code: http.StatusOK, body: `{"error":false,"code":200,"endpoints":[` + strings.Join(items, ",") + `]}`}
+		}
+
+		return e, stub, func(c ...string) {
+			lock.Lock()
+			defer lock.Unlock()
+			coordinators = c
+		}
+	}
+
+	t.Run("endpoints are swapped and changes are reported", func(t *testing.T) {
+		type change struct {
+			added, removed []string
+		}
+		var changes []change
+
+		e, stub, setCoordinators := newCluster(RoundRobinEndpointFactory, &DiscoveryEndpointOptions{
+			OnMembershipChange: func(added, removed []string) {
+				changes = append(changes, change{added, removed})
+			},
+		})
+		assert.Equal(t, []string{"http://seed:8529"}, e.List())
+
+		setCoordinators("tcp://seed:8529", "ssl://c2:8529")
+		changed, err := e.Refresh(context.Background(), stub)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, []string{"http://seed:8529", "https://c2:8529"}, e.List())
+		assert.Equal(t, []string{"http://seed:8529", "https://c2:8529", "http://seed:8529"}, getEndpoints(t, e, 3))
+
+		changed, err = e.Refresh(context.Background(), stub)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		setCoordinators("tcp://seed:8529", "tcp://c3:8529")
+		changed, err = e.Refresh(context.Background(), stub)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, []string{"http://seed:8529", "http://c3:8529"}, e.List())
+
+		require.Len(t, changes, 2)
+		assert.Equal(t, []string{"https://c2:8529"}, changes[0].added)
+		assert.Empty(t, changes[0].removed)
+		assert.Equal(t, []string{"http://c3:8529"}, changes[1].added)
+		assert.Equal(t, []string{"https://c2:8529"}, changes[1].removed)
+	})
+
+	t.Run("empty list does not remove the endpoints", func(t *testing.T) {
+		e, stub, _ := newCluster(RoundRobinEndpointFactory, nil)
+
+		changed, err := e.Refresh(context.Background(), stub)
+		require.Error(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, []string{"http://seed:8529"}, e.List())
+	})
+
+	t.Run("refresh errors are logged with the connection logger", func(t *testing.T) {
+		e, stub, _ := newCluster(RoundRobinEndpointFactory, &DiscoveryEndpointOptions{RefreshInterval: time.Millisecond})
+
+		lines := make(lineWriter, 10)
+		stub.config.Logger = log.NewSlogLogger(slog.New(slog.NewTextHandler(lines, nil)))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		e.StartRefreshing(ctx, stub)
+
+		select {
+		case line := <-lines:
+			assert.Contains(t, line, "unable to refresh the endpoints")
+		case <-time.After(5 * time.Second):
+			t.Fatal("the refresh error has not been logged")
+		}
+	})
+
+	t.Run("maglev", func(t *testing.T) {
+		e, stub, setCoordinators := newCluster(MaglevHashEndpointFactory(RequestDBNameValueExtractor), nil)
+
+		setCoordinators("tcp://seed:8529", "tcp://c2:8529", "tcp://c3:8529")
+		changed, err := e.Refresh(context.Background(), stub)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Len(t, e.List(), 3)
+
+		first, err := e.Get("", http.MethodGet, "_db/test/_api/version")
+		require.NoError(t, err)
+		for i := 0; i < 5; i++ {
+			ep, err := e.Get("", http.MethodGet, "_db/test/_api/document/col/key")
+			require.NoError(t, err)
+			assert.Equal(t, first, ep, "the same database must be handled by the same endpoint")
+		}
+	})
+}
@@ -0,0 +1,360 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHealthFailureThreshold is the default number of consecutive failures after which the endpoint is unhealthy.
+	DefaultHealthFailureThreshold = 3
+	// DefaultHealthRecoveryThreshold is the default number of consecutive successful probes after which the endpoint is healthy again.
+	DefaultHealthRecoveryThreshold = 2
+	// DefaultHealthLatencyAlpha is the default weight of the latest latency in the latency EWMA.
+	DefaultHealthLatencyAlpha = 0.2
+	// DefaultHealthProbeInterval is the default interval between the probes of the unhealthy endpoints.
+	DefaultHealthProbeInterval = 5 * time.Second
+	// DefaultHealthProbeTimeout is the default timeout of a single probe.
+	DefaultHealthProbeTimeout = 2 * time.Second
+)
+
+// HealthAwareEndpointOptions configures the endpoint created with NewHealthAwareEndpoints.
+// The zero value of each field means its default value.
+type HealthAwareEndpointOptions struct {
+	// FailureThreshold is the number of consecutive failures after which the endpoint is taken out of rotation.
+	FailureThreshold int
+
+	// RecoveryThreshold is the number of consecutive successful probes after which the endpoint is put back into rotation.
+	RecoveryThreshold int
+
+	// LatencyAlpha is the weight (between 0 and 1) of the latest latency in the exponentially weighted moving average.
+	LatencyAlpha float64
+
+	// ProbeInterval is the interval between the probes of the unhealthy endpoints.
+	ProbeInterval time.Duration
+
+	// ProbeTimeout is the timeout of a single probe.
+	ProbeTimeout time.Duration
+
+	// OnHealthChange is called when the endpoint is taken out of rotation or put back into it.
+	OnHealthChange func(endpoint string, healthy bool)
+}
+
+// EndpointHealth describes the health of the endpoint.
+type EndpointHealth struct {
+	// Endpoint is the URL of the endpoint.
+	Endpoint string
+
+	// Healthy is false when the endpoint is out of rotation.
+	Healthy bool
+
+	// ConsecutiveFailures is the number of failures since the last success.
+	ConsecutiveFailures int
+
+	// Latency is the exponentially weighted moving average of the latency of the successful requests.
+	Latency time.Duration
+
+	// LastError is the error of the last failure, if any.
+	LastError error
+}
+
+// HealthAwareEndpoint is the Endpoint which tracks the health of the endpoints and skips the unhealthy ones.
+type HealthAwareEndpoint interface {
+	Endpoint
+
+	// Next returns the healthy endpoint with the lowest latency, other than the excluded ones.
+	// It is used to fail over the request to another endpoint.
+	Next(exclude ...string) (string, error)
+
+	// ReportSuccess records the successful request to the endpoint.
+	ReportSuccess(endpoint string, latency time.Duration)
+
+	// ReportFailure records the failed request to the endpoint.
+	ReportFailure(endpoint string, err error)
+
+	// Health returns the health of all known endpoints.
+	Health() []EndpointHealth
+
+	// Probe sends the `/_api/version` request to each unhealthy endpoint and records the results.
+	Probe(ctx context.Context, conn Connection)
+
+	// StartProbing probes the unhealthy endpoints periodically in the background, until the context is done.
+	// The given connection should not be wrapped with NewFailoverWrapper, otherwise the probes are recorded twice.
+	StartProbing(ctx context.Context, conn Connection)
+}
+
+// NewHealthAwareEndpoints returns the Endpoint which uses the base Endpoint (e.g. round-robin or maglev) to choose the endpoint.
+// When the chosen endpoint is unhealthy, the next healthy endpoint from the list is returned instead.
+// If all endpoints are unhealthy, the endpoint chosen by the base Endpoint is returned.
+//
+// The health is updated with ReportSuccess and ReportFailure, which is done automatically by the connection wrapped with NewFailoverWrapper.
+func NewHealthAwareEndpoints(base Endpoint, opts *HealthAwareEndpointOptions) HealthAwareEndpoint {
+	e := &healthAwareEndpoints{
+		base:   base,
+		health: map[string]*endpointHealth{},
+	}
+
+	if opts != nil {
+		e.opts = *opts
+	}
+
+	if e.opts.FailureThreshold <= 0 {
+		e.opts.FailureThreshold = DefaultHealthFailureThreshold
+	}
+	if e.opts.RecoveryThreshold <= 0 {
+		e.opts.RecoveryThreshold = DefaultHealthRecoveryThreshold
+	}
+	if e.opts.LatencyAlpha <= 0 || e.opts.LatencyAlpha > 1 {
+		e.opts.LatencyAlpha = DefaultHealthLatencyAlpha
+	}
+	if e.opts.ProbeInterval <= 0 {
+		e.opts.ProbeInterval = DefaultHealthProbeInterval
+	}
+	if e.opts.ProbeTimeout <= 0 {
+		e.opts.ProbeTimeout = DefaultHealthProbeTimeout
+	}
+
+	return e
+}
+
+type endpointHealth struct {
+	unhealthy            bool
+	consecutiveFailures  int
+	consecutiveSuccesses int
+	latency              time.Duration
+	lastError            error
+}
+
+type healthAwareEndpoints struct {
+	base Endpoint
+	opts HealthAwareEndpointOptions
+
+	lock   sync.RWMutex
+	health map[string]*endpointHealth
+}
+
+func (e *healthAwareEndpoints) List() []string {
+	return e.base.List()
+}
+
+func (e *healthAwareEndpoints) Get(providedEp, method, path string) (string, error) {
+	chosen, err := e.base.Get(providedEp, method, path)
+	if err != nil || providedEp != "" {
+		return chosen, err
+	}
+
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	if e.isHealthy(chosen) {
+		return chosen, nil
+	}
+
+	// Take the next healthy endpoint from the list, so the choice is stable for the same base endpoint.
+	endpoints := e.base.List()
+	for i, ep := range endpoints {
+		if ep != chosen {
+			continue
+		}
+
+		for j := 1; j < len(endpoints); j++ {
+			if next := endpoints[(i+j)%len(endpoints)]; e.isHealthy(next) {
+				return next, nil
+			}
+		}
+	}
+
+	return chosen, nil
+}
+
+func (e *healthAwareEndpoints) Next(exclude ...string) (string, error) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	var best string
+	var bestLatency time.Duration
+	for _, ep := range e.base.List() {
+		if !e.isHealthy(ep) || contains(exclude, ep) {
+			continue
+		}
+
+		var latency time.Duration
+		if h, ok := e.health[ep]; ok {
+			latency = h.latency
+		}
+
+		if best == "" || latency < bestLatency {
+			best, bestLatency = ep, latency
+		}
+	}
+
+	if best == "" {
+		return "", errors.New("no healthy endpoints available")
+	}
+
+	return best, nil
+}
+
+// isHealthy must be called with the lock held.
+func (e *healthAwareEndpoints) isHealthy(endpoint string) bool {
+	h, ok := e.health[endpoint]
+	return !ok || !h.unhealthy
+}
+
+func (e *healthAwareEndpoints) ReportSuccess(endpoint string, latency time.Duration) {
+	changed := func() bool {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+
+		h := e.get(endpoint)
+		h.consecutiveFailures = 0
+		h.consecutiveSuccesses++
+		if h.latency == 0 {
+			h.latency = latency
+		} else {
+			h.latency = time.Duration(e.opts.LatencyAlpha*float64(latency) + (1-e.opts.LatencyAlpha)*float64(h.latency))
+		}
+
+		if h.unhealthy && h.consecutiveSuccesses >= e.opts.RecoveryThreshold {
+			h.unhealthy = false
+			return true
+		}
+		return false
+	}()
+
+	if changed && e.opts.OnHealthChange != nil {
+		e.opts.OnHealthChange(endpoint, true)
+	}
+}
+
+func (e *healthAwareEndpoints) ReportFailure(endpoint string, err error) {
+	changed := func() bool {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+
+		h := e.get(endpoint)
+		h.consecutiveSuccesses = 0
+		h.consecutiveFailures++
+		h.lastError = err
+
+		if !h.unhealthy && h.consecutiveFailures >= e.opts.FailureThreshold {
+			h.unhealthy = true
+			return true
+		}
+		return false
+	}()
+
+	if changed && e.opts.OnHealthChange != nil {
+		e.opts.OnHealthChange(endpoint, false)
+	}
+}
+
+// get returns the health of the endpoint, it must be called with the lock held.
+func (e *healthAwareEndpoints) get(endpoint string) *endpointHealth {
+	h, ok := e.health[endpoint]
+	if !ok {
+		h = &endpointHealth{}
+		e.health[endpoint] = h
+	}
+
+	return h
+}
+
+func (e *healthAwareEndpoints) Health() []EndpointHealth {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	endpoints := e.base.List()
+	result := make([]EndpointHealth, 0, len(endpoints))
+	for _, ep := range endpoints {
+		health := EndpointHealth{Endpoint: ep, Healthy: true}
+		if h, ok := e.health[ep]; ok {
+			health.Healthy = !h.unhealthy
+			health.ConsecutiveFailures = h.consecutiveFailures
+			health.Latency = h.latency
+			health.LastError = h.lastError
+		}
+		result = append(result, health)
+	}
+
+	return result
+}
+
+func (e *healthAwareEndpoints) Probe(ctx context.Context, conn Connection) {
+	for _, health := range e.Health() {
+		if health.Healthy {
+			continue
+		}
+
+		e.probe(ctx, conn, health.Endpoint)
+	}
+}
+
+func (e *healthAwareEndpoints) probe(ctx context.Context, conn Connection, endpoint string) {
+	ctx, cancel := context.WithTimeout(ctx, e.opts.ProbeTimeout)
+	defer cancel()
+
+	req, err := conn.NewRequestWithEndpoint(endpoint, http.MethodGet, "_api", "version")
+	if err != nil {
+		e.ReportFailure(endpoint, err)
+		return
+	}
+
+	start := time.Now()
+	if _, err := conn.Do(ctx, req, nil, http.StatusOK); err != nil {
+		e.ReportFailure(endpoint, err)
+		return
+	}
+
+	e.ReportSuccess(endpoint, time.Since(start))
+}
+
+func (e *healthAwareEndpoints) StartProbing(ctx context.Context, conn Connection) {
+	go func() {
+		ticker := time.NewTicker(e.opts.ProbeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.Probe(ctx, conn)
+			}
+		}
+	}()
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,226 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HealthAwareEndpoints(t *testing.T) {
+	endpoints := []string{"http://a", "http://b", "http://c"}
+
+	t.Run("unhealthy endpoint is skipped", func(t *testing.T) {
+		var changes []string
+		e := NewHealthAwareEndpoints(NewRoundRobinEndpoints(endpoints), &HealthAwareEndpointOptions{
+			FailureThreshold: 2,
+			OnHealthChange: func(endpoint string, healthy bool) {
+				changes = append(changes, endpoint)
+			},
+		})
+
+		e.ReportFailure("http://b", errors.New("connection refused"))
+		assert.Equal(t, []string{"http://a", "http://b", "http://c"}, getEndpoints(t, e, 3), "one failure is below the threshold")
+
+		e.ReportFailure("http://b", errors.New("connection refused"))
+		assert.Equal(t, []string{"http://a", "http://c", "http://c"}, getEndpoints(t, e, 3))
+		assert.Equal(t, []string{"http://b"}, changes)
+
+		ep, err := e.Get("http://b", http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		assert.Equal(t, "http://b", ep, "explicitly requested endpoint is always returned")
+
+		health := e.Health()
+		require.Len(t, health, 3)
+		assert.False(t, health[1].Healthy)
+		assert.Equal(t, 2, health[1].ConsecutiveFailures)
+		assert.EqualError(t, health[1].LastError, "connection refused")
+	})
+
+	t.Run("all endpoints are unhealthy", func(t *testing.T) {
+		e := NewHealthAwareEndpoints(NewRoundRobinEndpoints(endpoints), &HealthAwareEndpointOptions{FailureThreshold: 1})
+		for _, ep := range endpoints {
+			e.ReportFailure(ep, errors.New("down"))
+		}
+
+		assert.Equal(t, endpoints, getEndpoints(t, e, 3))
+
+		_, err := e.Next()
+		require.Error(t, err)
+	})
+
+	t.Run("next endpoint has the lowest latency", func(t *testing.T) {
+		e := NewHealthAwareEndpoints(NewRoundRobinEndpoints(endpoints), &HealthAwareEndpointOptions{LatencyAlpha: 0.5})
+		e.ReportSuccess("http://a", 10*time.Millisecond)
+		e.ReportSuccess("http://b", 20*time.Millisecond)
+		e.ReportSuccess("http://c", 40*time.Millisecond)
+		e.ReportSuccess("http://c", 0)
+
+		assert.Equal(t, 20*time.Millisecond, e.Health()[2].Latency)
+
+		next, err := e.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "http://a", next)
+
+		next, err = e.Next("http://a")
+		require.NoError(t, err)
+		assert.Equal(t, "http://b", next)
+	})
+
+	t.Run("probe brings the endpoint back", func(t *testing.T) {
+		var lock sync.Mutex
+		down := true
+
+		stub := newStubConnection()
+		stub.handler = func(_ context.Context, _ int, request Request) stubResponse {
+			lock.Lock()
+			defer lock.Unlock()
+
+			assert.Equal(t, "http://b", request.Endpoint())
+			if down {
+				return stubResponse{err: errors.New("connection refused")}
+			}
+			return stubResponse{code: http.StatusOK, body: `{"server":"arango"}`}
+		}
+
+		e := NewHealthAwareEndpoints(NewRoundRobinEndpoints(endpoints), &HealthAwareEndpointOptions{
+			FailureThreshold:  1,
+			RecoveryThreshold: 2,
+		})
+		e.ReportFailure("http://b", errors.New("connection refused"))
+
+		e.Probe(context.Background(), stub)
+		assert.False(t, e.Health()[1].Healthy)
+
+		lock.Lock()
+		down = false
+		lock.Unlock()
+
+		e.Probe(context.Background(), stub)
+		assert.False(t, e.Health()[1].Healthy, "one successful probe is below the recovery threshold")
+		e.Probe(context.Background(), stub)
+		assert.True(t, e.Health()[1].Healthy)
+
+		e.Probe(context.Background(), stub)
+		assert.Equal(t, 3, stub.callCount(), "healthy endpoints are not probed")
+	})
+}
+
+func Test_FailoverWrapper(t *testing.T) {
+	newConnection := func(down ...string) (Connection, HealthAwareEndpoint, *stubConnection) {
+		e := NewHealthAwareEndpoints(NewRoundRobinEndpoints([]string{"http://a", "http://b", "http://c"}),
+			&HealthAwareEndpointOptions{FailureThreshold: 1})
+
+		stub := newStubConnection()
+		stub.endpoint = e
+		stub.handler = func(_ context.Context, _ int, request Request) stubResponse {
+			for _, ep := range down {
+				if request.Endpoint() == ep {
+					return stubResponse{code: http.StatusServiceUnavailable}
+				}
+			}
+			return stubResponse{code: http.StatusOK, body: `{"endpoint":"` + request.Endpoint() + `"}`}
+		}
+
+		return NewFailoverWrapper(e)(stub), e, stub
+	}
+
+	var output struct {
+		Endpoint string `json:"endpoint"`
+	}
+
+	t.Run("GET is failed over", func(t *testing.T) {
+		conn, e, stub := newConnection("http://a")
+
+		req, err := conn.NewRequest(http.MethodGet, "_db", "test", "_api", "version")
+		require.NoError(t, err)
+		require.Equal(t, "http://a", req.Endpoint())
+		req.AddQuery("details", "true")
+
+		resp, err := conn.Do(context.Background(), req, &output, http.StatusOK)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code())
+		assert.Equal(t, "http://b", output.Endpoint)
+		assert.False(t, e.Health()[0].Healthy)
+
+		require.Len(t, stub.requests, 2)
+		assert.Equal(t, "http://b/_db/test/_api/version?details=true", stub.requests[1].URL())
+	})
+
+	t.Run("write is not failed over", func(t *testing.T) {
+		conn, e, stub := newConnection("http://a")
+
+		req, err := conn.NewRequest(http.MethodPost, "_api", "document", "col")
+		require.NoError(t, err)
+
+		resp, err := conn.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+		assert.Equal(t, 1, stub.callCount())
+		assert.False(t, e.Health()[0].Healthy)
+
+		req, err = conn.NewRequest(http.MethodPost, "_api", "document", "col")
+		require.NoError(t, err)
+		assert.Equal(t, "http://b", req.Endpoint(), "unhealthy endpoint is skipped")
+	})
+
+	t.Run("pinned request is not failed over", func(t *testing.T) {
+		conn, _, stub := newConnection("http://a")
+
+		req, err := conn.NewRequestWithEndpoint("http://a", http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		resp, err := conn.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+		assert.Equal(t, 1, stub.callCount())
+	})
+
+	t.Run("all endpoints fail", func(t *testing.T) {
+		conn, _, stub := newConnection("http://a", "http://b", "http://c")
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		resp, err := conn.Do(WithIdempotent(context.Background()), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+		assert.Equal(t, 3, stub.callCount())
+	})
+}
+
+func getEndpoints(t *testing.T, e Endpoint, n int) []string {
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ep, err := e.Get("", http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		result = append(result, ep)
+	}
+
+	return result
+}
@@ -50,6 +50,13 @@ func WithQuery(s, value string) RequestModifier {
 	}
 }
 
+func WithHeader(key, value string) RequestModifier {
+	return func(r Request) error {
+		r.AddHeader(key, value)
+		return nil
+	}
+}
+
 func applyArangoDBConfiguration(config ArangoDBConfiguration, ctx context.Context) RequestModifier {
 	return func(r Request) error {
 		// Set version header
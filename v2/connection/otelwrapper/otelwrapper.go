@@ -0,0 +1,461 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+// Package otelwrapper provides the connection wrapper which instruments the requests with OpenTelemetry traces and metrics.
+package otelwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+const (
+	// ScopeName is the name of the tracer and the meter of the instrumentation.
+	ScopeName = "github.com/arangodb/go-driver/v2/connection/otelwrapper"
+
+	// DBSystem is the value of the `db.system` attribute.
+	DBSystem = "arangodb"
+
+	// MetricDuration is the name of the histogram of the request durations in seconds.
+	MetricDuration = "db.client.operation.duration"
+
+	// MetricRequestSize is the name of the histogram of the request body sizes in bytes.
+	// It is recorded when the size of the request body is known.
+	MetricRequestSize = "db.client.request.size"
+
+	// MetricResponseSize is the name of the histogram of the response body sizes in bytes.
+	// It is recorded when the size of the response body is known.
+	MetricResponseSize = "db.client.response.size"
+)
+
+// Options configures the instrumentation.
+type Options struct {
+	// TracerProvider creates the tracer. The global provider is used if it is nil.
+	TracerProvider trace.TracerProvider
+
+	// MeterProvider creates the meter. The global provider is used if it is nil.
+	MeterProvider metric.MeterProvider
+
+	// Propagator injects the trace context into the request headers. The W3C trace context propagator is used if it is nil.
+	Propagator propagation.TextMapPropagator
+
+	// CaptureQueryText enables the `db.statement` attribute with the AQL query text of the cursor and explain requests.
+	// The bind parameters are never captured.
+	CaptureQueryText bool
+}
+
+// NewWrapper returns the connection wrapper which creates the client span per request, propagates the trace context
+// in the W3C `traceparent` header and records the duration and the body size histograms.
+//
+// The span follows the database semantic conventions: `db.system` is "arangodb", `db.name` is parsed from the
+// `/_db/{name}` prefix of the path, and `db.operation` is the method with the API path, e.g. "POST /_api/cursor".
+// The span of the streamed request ends when the response body is closed.
+//
+// The response size is the number of bytes read from the response body after the decompression. For the streamed
+// requests, it is the number of bytes read before the body is closed. The request size is the encoded body size
+// which is sent, and it is not known when the request body is streamed, or when the request is sent over VST.
+//
+// Do is performed with Stream of the wrapped connection, so the wrappers which override only Do,
+// e.g. connection.NewConnectionAsyncWrapper, must wrap this connection, and not the other way around.
+func NewWrapper(opts Options) connection.Wrapper {
+	i := NewInstrumentation(ScopeName, opts)
+
+	return func(c connection.Connection) connection.Connection {
+		return &otelConnection{
+			Connection:      c,
+			instrumentation: i,
+		}
+	}
+}
+
+// Instrumentation creates the spans and records the metrics of the requests.
+// It does not depend on the connection type, so it is shared by the v1 driver connection wrapper.
+type Instrumentation struct {
+	tracer       trace.Tracer
+	propagator   propagation.TextMapPropagator
+	duration     metric.Float64Histogram
+	requestSize  metric.Int64Histogram
+	responseSize metric.Int64Histogram
+	captureQuery bool
+}
+
+// RequestInfo describes the request which is instrumented.
+type RequestInfo struct {
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Path is the path of the request with the optional `/_db/{name}` prefix.
+	Path string
+
+	// Endpoint is the URL or the host of the server, if it is known before the request is sent.
+	Endpoint string
+
+	// Body is the request body. It is used only to capture the query text.
+	Body interface{}
+
+	// Carrier receives the trace context headers.
+	Carrier propagation.TextMapCarrier
+}
+
+// ResponseInfo describes the result of the request.
+type ResponseInfo struct {
+	// StatusCode is the HTTP compatible status code of the response. It is 0 if there is no response.
+	StatusCode int
+
+	// Endpoint is the URL or the host of the server which handled the request.
+	// The endpoint of the request is used if it is empty.
+	Endpoint string
+
+	// RequestSize is the size of the request body in bytes, or -1 if it is not known.
+	RequestSize int64
+
+	// ResponseSize is the size of the response body in bytes, or -1 if it is not known.
+	ResponseSize int64
+}
+
+// NewInstrumentation returns the instrumentation with the tracer and the meter of the given scope.
+func NewInstrumentation(scopeName string, opts Options) *Instrumentation {
+	tp := opts.TracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	mp := opts.MeterProvider
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+
+	propagator := opts.Propagator
+	if propagator == nil {
+		propagator = propagation.TraceContext{}
+	}
+
+	meter := mp.Meter(scopeName)
+
+	duration, err := meter.Float64Histogram(MetricDuration,
+		metric.WithDescription("Duration of the ArangoDB requests."), metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	requestSize, err := meter.Int64Histogram(MetricRequestSize,
+		metric.WithDescription("Size of the ArangoDB request bodies."), metric.WithUnit("By"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	responseSize, err := meter.Int64Histogram(MetricResponseSize,
+		metric.WithDescription("Size of the ArangoDB response bodies."), metric.WithUnit("By"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return &Instrumentation{
+		tracer:       tp.Tracer(scopeName),
+		propagator:   propagator,
+		duration:     duration,
+		requestSize:  requestSize,
+		responseSize: responseSize,
+		captureQuery: opts.CaptureQueryText,
+	}
+}
+
+// Start starts the span of the request and injects its context into the carrier of the request.
+// The returned function ends the span and records the metrics. Only its first call is recorded.
+func (i *Instrumentation) Start(ctx context.Context, request RequestInfo) (context.Context, func(ResponseInfo, error)) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	dbName, operation := ParsePath(request.Method, request.Path)
+
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemKey.String(DBSystem),
+		semconv.DBName(dbName),
+		semconv.DBOperation(operation),
+		semconv.HTTPRequestMethodKey.String(request.Method),
+	}
+	metricAttrs := append([]attribute.KeyValue{}, attrs...)
+
+	if i.captureQuery {
+		if query, ok := QueryText(operation, request.Body); ok {
+			attrs = append(attrs, semconv.DBStatement(query))
+		}
+	}
+
+	ctx, span := i.tracer.Start(ctx, operation+" "+dbName, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	if request.Carrier != nil {
+		i.propagator.Inject(ctx, request.Carrier)
+	}
+
+	started := time.Now()
+	var once sync.Once
+
+	return ctx, func(resp ResponseInfo, err error) {
+		once.Do(func() {
+			defer span.End()
+
+			endpoint := resp.Endpoint
+			if endpoint == "" {
+				endpoint = request.Endpoint
+			}
+			server := serverAttributes(endpoint)
+			span.SetAttributes(server...)
+			metricAttrs = append(metricAttrs, server...)
+
+			if resp.StatusCode != 0 {
+				code := attribute.Int(string(semconv.HTTPResponseStatusCodeKey), resp.StatusCode)
+				span.SetAttributes(code)
+				metricAttrs = append(metricAttrs, code)
+
+				if resp.StatusCode >= http.StatusInternalServerError {
+					span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+				}
+			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				metricAttrs = append(metricAttrs, semconv.ErrorTypeKey.String("error"))
+			}
+
+			options := metric.WithAttributes(metricAttrs...)
+			if resp.RequestSize >= 0 {
+				i.requestSize.Record(ctx, resp.RequestSize, options)
+			}
+			if resp.ResponseSize >= 0 {
+				i.responseSize.Record(ctx, resp.ResponseSize, options)
+			}
+			i.duration.Record(ctx, time.Since(started).Seconds(), options)
+		})
+	}
+}
+
+type otelConnection struct {
+	connection.Connection
+
+	instrumentation *Instrumentation
+}
+
+func (o *otelConnection) Do(ctx context.Context, request connection.Request, output interface{}, allowedStatusCodes ...int) (connection.Response, error) {
+	ctx, finish := o.start(ctx, request)
+
+	resp, body, err := o.Connection.Stream(ctx, request)
+	if err != nil || body == nil {
+		finish(responseInfo(resp, -1), err)
+		return resp, err
+	}
+
+	counted := &countingBody{ReadCloser: body}
+	resp, err = connection.DecodeStream(o.Decoder(resp.Content()), resp, counted, output, allowedStatusCodes...)
+	finish(responseInfo(resp, counted.size), err)
+
+	return resp, err
+}
+
+func (o *otelConnection) Stream(ctx context.Context, request connection.Request) (connection.Response, io.ReadCloser, error) {
+	ctx, finish := o.start(ctx, request)
+
+	resp, body, err := o.Connection.Stream(ctx, request)
+	if err != nil || body == nil {
+		finish(responseInfo(resp, -1), err)
+		return resp, body, err
+	}
+
+	counted := &countingBody{ReadCloser: body}
+	counted.finish = func() { finish(responseInfo(resp, counted.size), nil) }
+
+	return resp, counted, nil
+}
+
+func (o *otelConnection) start(ctx context.Context, request connection.Request) (context.Context, func(ResponseInfo, error)) {
+	info := RequestInfo{
+		Method:  request.Method(),
+		Carrier: requestCarrier{request: request},
+	}
+	if u, err := url.Parse(request.URL()); err == nil {
+		info.Path = u.Path
+		info.Endpoint = u.Host
+	}
+	if getter, ok := request.(interface{ GetBody() interface{} }); ok {
+		info.Body = getter.GetBody()
+	}
+
+	return o.instrumentation.Start(ctx, info)
+}
+
+// responseInfo returns the result of the request with the given number of the response bytes.
+// The request size is taken from the HTTP request, which knows it when its body is not streamed.
+func responseInfo(resp connection.Response, responseSize int64) ResponseInfo {
+	info := ResponseInfo{RequestSize: -1, ResponseSize: -1}
+	if resp == nil {
+		return info
+	}
+
+	info.StatusCode = resp.Code()
+	info.Endpoint = resp.Endpoint()
+	info.ResponseSize = responseSize
+	if raw := resp.RawResponse(); raw != nil && raw.Request != nil {
+		if r := raw.Request; r.Body == nil || r.Body == http.NoBody {
+			info.RequestSize = 0
+		} else if r.ContentLength > 0 {
+			info.RequestSize = r.ContentLength
+		}
+	}
+
+	return info
+}
+
+// ParsePath returns the database name and the operation of the request path.
+// The database name is taken from the `/_db/{name}` prefix, and it is "_system" if there is no prefix.
+// The operation is the method with the first two segments of the API path, e.g. "GET /_api/document",
+// so it does not contain the high-cardinality values, like document keys.
+func ParsePath(method, requestPath string) (string, string) {
+	dbName := "_system"
+
+	segments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	for j := 0; j+1 < len(segments); j++ {
+		if segments[j] == "_db" {
+			if name, err := url.PathUnescape(segments[j+1]); err == nil {
+				dbName = name
+			} else {
+				dbName = segments[j+1]
+			}
+			segments = segments[j+2:]
+			break
+		}
+	}
+
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+
+	return dbName, method + " /" + strings.Join(segments, "/")
+}
+
+// QueryText returns the AQL query text of the request body, if the operation is the cursor or the explain request.
+func QueryText(operation string, body interface{}) (string, bool) {
+	if body == nil {
+		return "", false
+	}
+
+	switch operation {
+	case "POST /_api/cursor", "POST /_api/explain", "POST /_api/query":
+	default:
+		return "", false
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", false
+	}
+
+	var query struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(data, &query); err != nil || query.Query == "" {
+		return "", false
+	}
+
+	return query.Query, true
+}
+
+// serverAttributes returns the server address and port of the endpoint, which is the URL or the host.
+func serverAttributes(endpoint string) []attribute.KeyValue {
+	if endpoint == "" {
+		return nil
+	}
+
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	address, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return []attribute.KeyValue{semconv.ServerAddress(host)}
+	}
+
+	attrs := []attribute.KeyValue{semconv.ServerAddress(address)}
+	if p, err := strconv.Atoi(port); err == nil {
+		attrs = append(attrs, semconv.ServerPort(p))
+	}
+
+	return attrs
+}
+
+// requestCarrier injects the trace context into the request headers.
+type requestCarrier struct {
+	request connection.Request
+}
+
+func (r requestCarrier) Get(key string) string {
+	v, _ := r.request.GetHeader(key)
+	return v
+}
+
+func (r requestCarrier) Set(key, value string) {
+	r.request.AddHeader(key, value)
+}
+
+// Keys is not supported, because the request does not list its headers. It is not needed to inject the context.
+func (r requestCarrier) Keys() []string {
+	return nil
+}
+
+// countingBody counts the bytes read from the response body, and it ends the span when the body is closed.
+type countingBody struct {
+	io.ReadCloser
+
+	size   int64
+	finish func()
+}
+
+func (c *countingBody) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.size += int64(n)
+	return n, err
+}
+
+func (c *countingBody) Close() error {
+	if c.finish != nil {
+		defer c.finish()
+	}
+
+	return c.ReadCloser.Close()
+}
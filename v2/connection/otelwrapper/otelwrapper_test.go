@@ -0,0 +1,262 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package otelwrapper
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+type testEnv struct {
+	conn     connection.Connection
+	spans    *tracetest.SpanRecorder
+	reader   *sdkmetric.ManualReader
+	received chan http.Header
+}
+
+func newTestEnv(t *testing.T, captureQuery bool, handler http.HandlerFunc) *testEnv {
+	env := &testEnv{
+		spans:    tracetest.NewSpanRecorder(),
+		reader:   sdkmetric.NewManualReader(),
+		received: make(chan http.Header, 10),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		env.received <- r.Header.Clone()
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	conn := connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:    connection.NewRoundRobinEndpoints([]string{server.URL}),
+		ContentType: connection.ApplicationJSON,
+	})
+
+	env.conn = NewWrapper(Options{
+		TracerProvider:   sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(env.spans)),
+		MeterProvider:    sdkmetric.NewMeterProvider(sdkmetric.WithReader(env.reader)),
+		CaptureQueryText: captureQuery,
+	})(conn)
+
+	return env
+}
+
+func attributesOf(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	result := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		result[kv.Key] = kv.Value
+	}
+	return result
+}
+
+func Test_ParsePath(t *testing.T) {
+	tests := map[string]struct {
+		path      string
+		db        string
+		operation string
+	}{
+		"system database":  {path: "/_api/version", db: "_system", operation: "GET /_api/version"},
+		"database prefix":  {path: "/_db/test/_api/cursor", db: "test", operation: "GET /_api/cursor"},
+		"escaped database": {path: "/_db/t%C3%A9st/_api/collection", db: "tést", operation: "GET /_api/collection"},
+		"document key":     {path: "/_db/test/_api/document/users/123", db: "test", operation: "GET /_api/document"},
+		"root":             {path: "/", db: "_system", operation: "GET /"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, operation := ParsePath(http.MethodGet, test.path)
+			assert.Equal(t, test.db, db)
+			assert.Equal(t, test.operation, operation)
+		})
+	}
+}
+
+func Test_QueryText(t *testing.T) {
+	body := map[string]interface{}{"query": "FOR d IN users RETURN d", "bindVars": map[string]interface{}{"secret": 1}}
+
+	query, ok := QueryText("POST /_api/cursor", body)
+	require.True(t, ok)
+	assert.Equal(t, "FOR d IN users RETURN d", query)
+
+	_, ok = QueryText("POST /_api/document", body)
+	assert.False(t, ok)
+
+	_, ok = QueryText("POST /_api/cursor", nil)
+	assert.False(t, ok)
+}
+
+// histogramSum returns the sum of the single data point of the histogram.
+func histogramSum(t *testing.T, env *testEnv, name string) int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, env.reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if m.Name == name {
+			histogram := m.Data.(metricdata.Histogram[int64])
+			require.Len(t, histogram.DataPoints, 1)
+			return histogram.DataPoints[0].Sum
+		}
+	}
+
+	require.Failf(t, "missing metric", "metric %s is not recorded", name)
+	return 0
+}
+
+func Test_Wrapper_Do(t *testing.T) {
+	requestSize := make(chan int, 1)
+	env := newTestEnv(t, true, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		requestSize <- len(data)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"result":[1]}`))
+	})
+
+	ctx := context.Background()
+	url := connection.NewUrl("_db", "test", "_api", "cursor")
+	body := map[string]interface{}{"query": "RETURN 1"}
+
+	var output map[string]interface{}
+	resp, err := connection.CallPost(ctx, env.conn, url, &output, body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Code())
+
+	headers := <-env.received
+	assert.NotEmpty(t, headers.Get("traceparent"))
+
+	spans := env.spans.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "POST /_api/cursor test", span.Name())
+	assert.Contains(t, headers.Get("traceparent"), span.SpanContext().TraceID().String())
+
+	attrs := attributesOf(span.Attributes())
+	assert.Equal(t, DBSystem, attrs[semconv.DBSystemKey].AsString())
+	assert.Equal(t, "test", attrs[semconv.DBNameKey].AsString())
+	assert.Equal(t, "POST /_api/cursor", attrs[semconv.DBOperationKey].AsString())
+	assert.Equal(t, "RETURN 1", attrs[semconv.DBStatementKey].AsString())
+	assert.Equal(t, int64(http.StatusCreated), attrs[semconv.HTTPResponseStatusCodeKey].AsInt64())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, env.reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	names := map[string]metricdata.Aggregation{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		names[m.Name] = m.Data
+	}
+	require.Contains(t, names, MetricDuration)
+	require.Contains(t, names, MetricRequestSize)
+	require.Contains(t, names, MetricResponseSize)
+
+	assert.Equal(t, int64(<-requestSize), histogramSum(t, env, MetricRequestSize))
+	assert.Equal(t, int64(len(`{"result":[1]}`)), histogramSum(t, env, MetricResponseSize))
+}
+
+func Test_Wrapper_QueryTextDisabled(t *testing.T) {
+	env := newTestEnv(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := connection.CallPost(context.Background(), env.conn, connection.NewUrl("_api", "cursor"), nil, map[string]interface{}{"query": "RETURN 1"})
+	require.NoError(t, err)
+
+	spans := env.spans.Ended()
+	require.Len(t, spans, 1)
+	assert.NotContains(t, attributesOf(spans[0].Attributes()), semconv.DBStatementKey)
+	assert.Equal(t, "POST /_api/cursor _system", spans[0].Name())
+}
+
+func Test_Wrapper_Error(t *testing.T) {
+	env := newTestEnv(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	resp, err := connection.CallGet(context.Background(), env.conn, connection.NewUrl("_api", "version"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+
+	spans := env.spans.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+}
+
+func Test_Wrapper_Stream(t *testing.T) {
+	env := newTestEnv(t, false, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("data"))
+	})
+
+	req, err := env.conn.NewRequest(http.MethodGet, connection.NewUrl("_api", "version"))
+	require.NoError(t, err)
+
+	_, body, err := env.conn.Stream(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, env.spans.Ended(), "span must not end before the body is closed")
+
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+	require.NoError(t, body.Close())
+
+	require.Len(t, env.spans.Ended(), 1)
+}
+
+func Test_Wrapper_StreamChunked(t *testing.T) {
+	env := newTestEnv(t, false, func(w http.ResponseWriter, r *http.Request) {
+		// The flushed response is chunked, so it has no Content-Length header.
+		_, _ = w.Write([]byte("da"))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("ta"))
+	})
+
+	req, err := env.conn.NewRequest(http.MethodGet, connection.NewUrl("_api", "version"))
+	require.NoError(t, err)
+
+	resp, body, err := env.conn.Stream(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Header("Content-Length"))
+
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+	require.NoError(t, body.Close())
+
+	assert.Equal(t, int64(0), histogramSum(t, env, MetricRequestSize))
+	assert.Equal(t, int64(len("data")), histogramSum(t, env, MetricResponseSize))
+}
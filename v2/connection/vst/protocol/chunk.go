@@ -0,0 +1,149 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package protocol
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+type chunk struct {
+	chunkX        uint32
+	MessageID     uint64
+	MessageLength uint64
+	Data          []byte
+}
+
+// chunkHeaderSize is the size of the VST 1.1 chunk header.
+const chunkHeaderSize = 24
+
+// buildChunks splits the message parts into chunks which are not bigger than maxChunkSize (including the header).
+func buildChunks(messageID uint64, maxChunkSize uint32, messageParts ...[]byte) ([]chunk, error) {
+	if maxChunkSize <= chunkHeaderSize {
+		return nil, errors.Errorf("maxChunkSize is too small (%d)", maxChunkSize)
+	}
+	messageLength := uint64(0)
+	for _, m := range messageParts {
+		messageLength += uint64(len(m))
+	}
+	minChunkCount := int(messageLength / uint64(maxChunkSize))
+	maxDataLength := int(maxChunkSize - chunkHeaderSize)
+	chunks := make([]chunk, 0, minChunkCount+len(messageParts))
+	chunkIndex := uint32(0)
+	for _, m := range messageParts {
+		offset := 0
+		remaining := len(m)
+		for remaining > 0 {
+			dataLength := remaining
+			if dataLength > maxDataLength {
+				dataLength = maxDataLength
+			}
+			chunks = append(chunks, chunk{
+				chunkX:        chunkIndex << 1,
+				MessageID:     messageID,
+				MessageLength: messageLength,
+				Data:          m[offset : offset+dataLength],
+			})
+			remaining -= dataLength
+			offset += dataLength
+			chunkIndex++
+		}
+	}
+	if len(chunks) == 0 {
+		return nil, errors.Errorf("message %d is empty", messageID)
+	}
+	// Set chunkX of first chunk
+	if len(chunks) == 1 {
+		chunks[0].chunkX = 3
+	} else {
+		chunks[0].chunkX = uint32((len(chunks) << 1) + 1)
+	}
+	return chunks, nil
+}
+
+// readChunk reads a single VST 1.1 chunk from the reader.
+func readChunk(r io.Reader) (chunk, error) {
+	hdr := [chunkHeaderSize]byte{}
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return chunk{}, errors.WithStack(err)
+	}
+	le := binary.LittleEndian
+	length := le.Uint32(hdr[0:])
+	if length < chunkHeaderSize {
+		return chunk{}, errors.Errorf("invalid chunk length %d", length)
+	}
+
+	data := make([]byte, length-chunkHeaderSize)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return chunk{}, errors.WithStack(err)
+	}
+	return chunk{
+		chunkX:        le.Uint32(hdr[4:]),
+		MessageID:     le.Uint64(hdr[8:]),
+		MessageLength: le.Uint64(hdr[16:]),
+		Data:          data,
+	}, nil
+}
+
+// WriteTo writes the chunk in the VST 1.1 format.
+func (c chunk) WriteTo(w io.Writer) (int64, error) {
+	le := binary.LittleEndian
+	hdr := [chunkHeaderSize]byte{}
+
+	le.PutUint32(hdr[0:], uint32(len(c.Data)+len(hdr))) // length
+	le.PutUint32(hdr[4:], c.chunkX)                     // chunkX
+	le.PutUint64(hdr[8:], c.MessageID)                  // message ID
+	le.PutUint64(hdr[16:], c.MessageLength)             // message length
+
+	if n, err := w.Write(hdr[:]); err != nil {
+		return int64(n), errors.WithStack(err)
+	}
+
+	n, err := w.Write(c.Data)
+	result := int64(n) + int64(len(hdr))
+	if err != nil {
+		return result, errors.WithStack(err)
+	}
+	return result, nil
+}
+
+// IsFirst returns true if it is the first chunk of the message.
+func (c chunk) IsFirst() bool {
+	return (c.chunkX & 0x01) == 1
+}
+
+// Index returns the position of the chunk in the message.
+func (c chunk) Index() uint32 {
+	if c.IsFirst() {
+		return 0
+	}
+	return c.chunkX >> 1
+}
+
+// NumberOfChunks returns the number of chunks of the message. It is known only by the first chunk.
+func (c chunk) NumberOfChunks() uint32 {
+	if c.IsFirst() {
+		return c.chunkX >> 1
+	}
+	return 0
+}
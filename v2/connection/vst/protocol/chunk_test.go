@@ -0,0 +1,125 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package protocol
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadChunk(t *testing.T) {
+	raw, err := hex.DecodeString("1b0000000200000037020000000000000c00000000000000040506")
+	require.NoError(t, err)
+
+	c, err := readChunk(bytes.NewReader(raw))
+	require.NoError(t, err)
+	require.False(t, c.IsFirst())
+	require.Equal(t, uint32(1), c.Index())
+	require.Equal(t, uint32(0), c.NumberOfChunks())
+	require.Equal(t, uint64(567), c.MessageID)
+	require.Equal(t, uint64(12), c.MessageLength)
+	require.Equal(t, []byte{4, 5, 6}, c.Data)
+
+	_, err = readChunk(bytes.NewReader(raw[:10]))
+	require.Error(t, err)
+}
+
+func Test_BuildChunks(t *testing.T) {
+	parts := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9, 10, 11, 12},
+	}
+
+	tests := map[string]struct {
+		maxChunkSize uint32
+		expected     []string
+	}{
+		"one part per chunk": {
+			maxChunkSize: chunkHeaderSize + 3,
+			expected: []string{
+				"1b0000000900000037020000000000000c00000000000000010203",
+				"1b0000000200000037020000000000000c00000000000000040506",
+				"1b0000000400000037020000000000000c00000000000000070809",
+				"1b0000000600000037020000000000000c000000000000000a0b0c",
+			},
+		},
+		"bigger chunks": {
+			maxChunkSize: chunkHeaderSize + 6,
+			expected: []string{
+				"1b0000000700000037020000000000000c00000000000000010203",
+				"1b0000000200000037020000000000000c00000000000000040506",
+				"1e0000000400000037020000000000000c000000000000000708090a0b0c",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			chunks, err := buildChunks(567, test.maxChunkSize, parts...)
+			require.NoError(t, err)
+			require.Len(t, chunks, len(test.expected))
+
+			for i, expected := range test.expected {
+				var buf bytes.Buffer
+				_, err := chunks[i].WriteTo(&buf)
+				require.NoError(t, err)
+				require.Equal(t, expected, hex.EncodeToString(buf.Bytes()), "chunk %d", i)
+			}
+		})
+	}
+
+	chunks, err := buildChunks(567, defaultMaxChunkSize, []byte{1, 2, 3})
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	require.True(t, chunks[0].IsFirst())
+	require.Equal(t, uint32(1), chunks[0].NumberOfChunks())
+
+	_, err = buildChunks(1, chunkHeaderSize, parts...)
+	require.Error(t, err)
+}
+
+func Test_MessageAssemble(t *testing.T) {
+	chunks, err := buildChunks(7, chunkHeaderSize+2, []byte{1, 2, 3, 4, 5})
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+
+	m := &message{ID: 7, responseChan: make(chan Message, 1)}
+
+	// Chunks may arrive in any order.
+	m.addChunk(chunks[2])
+	require.False(t, m.assemble())
+	m.addChunk(chunks[0])
+	require.False(t, m.assemble())
+	m.addChunk(chunks[1])
+	require.True(t, m.assemble())
+	require.Equal(t, []byte{1, 2, 3, 4, 5}, m.Data)
+
+	m.notifyListener()
+	response, ok := <-m.responseChan
+	require.True(t, ok)
+	require.Equal(t, uint64(7), response.ID)
+	_, ok = <-m.responseChan
+	require.False(t, ok)
+}
@@ -0,0 +1,221 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+// Connection is a single socket connection to a server.
+type Connection struct {
+	lastMessageID uint64
+	maxChunkSize  uint32
+	msgStore      messageStore
+	conn          net.Conn
+	writeMutex    sync.Mutex
+	closing       int32
+	lastActivity  atomic.Int64
+	configured    int32 // Set to 1 after the configuration callback has finished without errors.
+}
+
+const (
+	defaultMaxChunkSize = 30000
+)
+
+var vstProtocolHeader = []byte("VST/1.1\r\n\r\n")
+
+// dial opens a new connection to the server on the given address.
+func dial(ctx context.Context, addr string, tlsConfig *tls.Config) (*Connection, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		tcpConn.SetKeepAlive(true)
+		tcpConn.SetNoDelay(true)
+	}
+
+	if tlsConfig != nil {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	if _, err := conn.Write(vstProtocolHeader); err != nil {
+		conn.Close()
+		return nil, errors.WithStack(err)
+	}
+
+	c := &Connection{
+		maxChunkSize: defaultMaxChunkSize,
+		conn:         conn,
+	}
+	c.updateLastActivity()
+
+	// Start reading responses
+	go c.readChunkLoop()
+
+	return c, nil
+}
+
+// load returns an indication of the amount of work this connection has.
+// 0 means no work at all, >0 means some work.
+func (c *Connection) load() int {
+	return c.msgStore.Size()
+}
+
+// Close the connection to the server.
+// The response channels of all pending messages are closed.
+func (c *Connection) Close() error {
+	if atomic.CompareAndSwapInt32(&c.closing, 0, 1) {
+		err := c.conn.Close()
+		c.msgStore.ForEach(func(m *message) {
+			m.closeResponseChan()
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// IsClosed returns true when the connection is closed, false otherwise.
+func (c *Connection) IsClosed() bool {
+	return atomic.LoadInt32(&c.closing) == 1
+}
+
+// IsConfigured returns true when the configuration callback has finished on this connection, without errors.
+func (c *Connection) IsConfigured() bool {
+	return atomic.LoadInt32(&c.configured) == 1
+}
+
+// Send sends a message (consisting of given parts) to the server and returns
+// a channel on which the response will be delivered.
+// When the connection is closed before a response was received, the returned
+// channel will be closed.
+func (c *Connection) Send(ctx context.Context, messageParts ...[]byte) (<-chan Message, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	msgID := atomic.AddUint64(&c.lastMessageID, 1)
+	chunks, err := buildChunks(msgID, c.maxChunkSize, messageParts...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// Prepare for receiving a response
+	m := c.msgStore.Add(msgID)
+	if c.IsClosed() {
+		// The connection has been closed before the message was stored, so nobody would close its channel.
+		c.msgStore.Remove(msgID)
+		return nil, errors.Errorf("connection is closed")
+	}
+
+	sendErrors := make(chan error, 1)
+	deadline, _ := ctx.Deadline()
+	go func() {
+		defer close(sendErrors)
+		for _, chunk := range chunks {
+			if err := c.sendChunk(deadline, chunk); err != nil {
+				c.msgStore.Remove(msgID)
+				sendErrors <- err
+				return
+			}
+		}
+	}()
+
+	// Wait for sending to be ready, or context to be cancelled.
+	select {
+	case err := <-sendErrors:
+		if err != nil {
+			return nil, err
+		}
+		return m.responseChan, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// sendChunk sends a single chunk to the server.
+func (c *Connection) sendChunk(deadline time.Time, chunk chunk) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	c.conn.SetWriteDeadline(deadline)
+	_, err := chunk.WriteTo(c.conn)
+	c.updateLastActivity()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// readChunkLoop reads chunks from the connection until it is closed.
+// Any read error closes the connection, because the stream of chunks can not be resynchronized.
+func (c *Connection) readChunkLoop() {
+	for {
+		chunk, err := readChunk(c.conn)
+		c.updateLastActivity()
+		if err != nil {
+			if !c.IsClosed() {
+				log.Debugf("Closing VST connection after read error: %s", err.Error())
+				c.Close()
+			}
+			return
+		}
+		c.processChunk(chunk)
+	}
+}
+
+// processChunk adds the given chunk to its message and notifies the listener
+// when the message is complete.
+func (c *Connection) processChunk(chunk chunk) {
+	m := c.msgStore.Get(chunk.MessageID)
+	if m == nil {
+		// Unexpected chunk, ignore it
+		return
+	}
+
+	m.addChunk(chunk)
+
+	if m.assemble() {
+		c.msgStore.Remove(m.ID)
+		m.notifyListener()
+	}
+}
+
+// updateLastActivity sets the lastActivity field to the current time.
+func (c *Connection) updateLastActivity() {
+	c.lastActivity.Store(time.Now().UnixNano())
+}
+
+// IsIdle returns true when the last activity was more than the given timeout ago.
+func (c *Connection) IsIdle(idleTimeout time.Duration) bool {
+	return time.Since(time.Unix(0, c.lastActivity.Load())) > idleTimeout && c.msgStore.Size() == 0
+}
@@ -0,0 +1,168 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package protocol
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Message is what is sent back to the client in response to a request.
+type Message struct {
+	ID   uint64
+	Data []byte
+}
+
+// message is assembled from the chunks of the response.
+type message struct {
+	ID   uint64
+	Data []byte
+
+	chunksMutex        sync.Mutex
+	chunks             []chunk
+	numberOfChunks     uint32
+	responseChanClosed int32
+	responseChan       chan Message
+}
+
+// closeResponseChan closes the response channel if needed.
+func (m *message) closeResponseChan() {
+	if atomic.CompareAndSwapInt32(&m.responseChanClosed, 0, 1) {
+		close(m.responseChan)
+	}
+}
+
+// notifyListener pushes itself onto its response channel and closes the response channel afterwards.
+func (m *message) notifyListener() {
+	if atomic.CompareAndSwapInt32(&m.responseChanClosed, 0, 1) {
+		m.responseChan <- Message{ID: m.ID, Data: m.Data}
+		close(m.responseChan)
+	}
+}
+
+// addChunk adds the given chunk to the list of chunks of the message.
+// If the given chunk is the first chunk, the expected number of chunks is recorded.
+func (m *message) addChunk(c chunk) {
+	m.chunksMutex.Lock()
+	defer m.chunksMutex.Unlock()
+
+	m.chunks = append(m.chunks, c)
+	if c.IsFirst() {
+		m.numberOfChunks = c.NumberOfChunks()
+	}
+}
+
+// assemble tries to assemble the message data from all chunks.
+// If not all chunks are available yet, nothing is done and false is returned.
+func (m *message) assemble() bool {
+	m.chunksMutex.Lock()
+	defer m.chunksMutex.Unlock()
+
+	if m.Data != nil {
+		// Already assembled
+		return true
+	}
+	if m.numberOfChunks == 0 || len(m.chunks) < int(m.numberOfChunks) {
+		// The first chunk or some other chunks have not arrived yet.
+		return false
+	}
+
+	if m.numberOfChunks == 1 {
+		m.Data = m.chunks[0].Data
+		return true
+	}
+
+	sort.Slice(m.chunks, func(i, j int) bool {
+		return m.chunks[i].Index() < m.chunks[j].Index()
+	})
+
+	data := make([]byte, m.chunks[0].MessageLength)
+	offset := 0
+	for _, c := range m.chunks {
+		copy(data[offset:], c.Data)
+		offset += len(c.Data)
+	}
+	m.Data = data
+	return true
+}
+
+// messageStore keeps the messages which are waiting for the response.
+type messageStore struct {
+	mutex    sync.RWMutex
+	messages map[uint64]*message
+}
+
+// Size returns the number of messages in this store.
+func (s *messageStore) Size() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return len(s.messages)
+}
+
+// Get returns the message with given id, or nil if not found.
+func (s *messageStore) Get(id uint64) *message {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.messages[id]
+}
+
+// Add adds a new message to the store with given ID.
+// If the ID is not unique this function will panic.
+func (s *messageStore) Add(id uint64) *message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.messages == nil {
+		s.messages = make(map[uint64]*message)
+	}
+	if _, ok := s.messages[id]; ok {
+		panic(fmt.Sprintf("ID %v is not unique", id))
+	}
+
+	m := &message{
+		ID:           id,
+		responseChan: make(chan Message, 1),
+	}
+	s.messages[id] = m
+	return m
+}
+
+// Remove removes the message with given ID from the store.
+func (s *messageStore) Remove(id uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.messages, id)
+}
+
+// ForEach calls the given function for each message in the store.
+func (s *messageStore) ForEach(cb func(*message)) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, m := range s.messages {
+		cb(m)
+	}
+}
@@ -0,0 +1,220 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultIdleConnTimeout = time.Minute
+	DefaultConnLimit       = 3
+)
+
+// TransportConfig contains configuration options for Transport.
+type TransportConfig struct {
+	// IdleConnTimeout is the maximum amount of time an idle connection will remain idle before closing itself.
+	// The default is 1 minute (DefaultIdleConnTimeout).
+	IdleConnTimeout time.Duration
+
+	// ConnLimit is the upper limit to the number of connections to a single server.
+	// Due to the nature of the VST protocol, this value does not have to be high.
+	// The default is 3 (DefaultConnLimit).
+	ConnLimit int
+}
+
+// Transport manages client-server connections using the VST protocol to a specific host.
+type Transport struct {
+	TransportConfig
+
+	hostAddr            string
+	tlsConfig           *tls.Config
+	connMutex           sync.Mutex
+	connections         []*Connection
+	onConnectionCreated func(context.Context, *Connection) error
+}
+
+// NewTransport creates a new Transport for given address & tls settings.
+func NewTransport(hostAddr string, tlsConfig *tls.Config, config TransportConfig) *Transport {
+	if config.IdleConnTimeout == 0 {
+		config.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	if config.ConnLimit == 0 {
+		config.ConnLimit = DefaultConnLimit
+	}
+	return &Transport{
+		TransportConfig: config,
+		hostAddr:        hostAddr,
+		tlsConfig:       tlsConfig,
+	}
+}
+
+// Send sends a message (consisting of given parts) to the server and returns
+// a channel on which the response will be delivered.
+// When the connection is closed before a response was received, the returned
+// channel will be closed.
+func (c *Transport) Send(ctx context.Context, messageParts ...[]byte) (<-chan Message, error) {
+	conn, err := c.getConnection(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	result, err := conn.Send(ctx, messageParts...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return result, nil
+}
+
+// CloseIdleConnections closes all connections which are closed or have been idle for more than the configured idle timeout.
+func (c *Transport) CloseIdleConnections() (closed, remaining int) {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
+	for i := 0; i < len(c.connections); {
+		conn := c.connections[i]
+		if conn.IsClosed() || conn.IsIdle(c.IdleConnTimeout) {
+			c.connections = append(c.connections[:i], c.connections[i+1:]...)
+			go conn.Close()
+			closed++
+		} else {
+			i++
+		}
+	}
+
+	return closed, len(c.connections)
+}
+
+// CloseAllConnections closes all connections.
+func (c *Transport) CloseAllConnections() {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
+	for _, conn := range c.connections {
+		go conn.Close()
+	}
+	c.connections = nil
+}
+
+// SetOnConnectionCreated stores a callback function that is called every time a new connection has been created.
+// It is used to authenticate the connection before it is used for the requests.
+func (c *Transport) SetOnConnectionCreated(handler func(context.Context, *Connection) error) {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
+	c.onConnectionCreated = handler
+}
+
+// getConnection returns the first available connection, or when no such connection is available,
+// it creates a new connection.
+func (c *Transport) getConnection(ctx context.Context) (*Connection, error) {
+	conn, onCreated := c.getAvailableConnection()
+	if conn != nil {
+		return conn, nil
+	}
+
+	conn, err := c.createConnection(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if onCreated != nil {
+		if err := onCreated(ctx, conn); err != nil {
+			conn.Close()
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	// Mark the connection as ready
+	atomic.StoreInt32(&conn.configured, 1)
+
+	return conn, nil
+}
+
+// getAvailableConnection returns the connection with the least amount of traffic.
+// If no such connection is available or a new one should be created, nil is returned
+// together with the callback which must be invoked for the new connection.
+func (c *Transport) getAvailableConnection() (*Connection, func(context.Context, *Connection) error) {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+
+	var bestConn *Connection
+	bestConnLoad := 0
+	activeConnCount := 0
+	for _, conn := range c.connections {
+		if !conn.IsClosed() {
+			activeConnCount++
+			if conn.IsConfigured() {
+				connLoad := conn.load()
+				if bestConn == nil || connLoad < bestConnLoad {
+					bestConn = conn
+					bestConnLoad = connLoad
+				}
+			}
+		}
+	}
+
+	if bestConn == nil {
+		return nil, c.onConnectionCreated
+	}
+
+	// If the load is >0 AND the number of connections is below the limit, create a new one
+	if bestConnLoad > 0 && activeConnCount < c.ConnLimit {
+		return nil, c.onConnectionCreated
+	}
+
+	bestConn.updateLastActivity()
+	return bestConn, nil
+}
+
+// createConnection creates a new connection.
+func (c *Transport) createConnection(ctx context.Context) (*Connection, error) {
+	conn, err := dial(ctx, c.hostAddr, c.tlsConfig)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	c.connMutex.Lock()
+	c.connections = append(c.connections, conn)
+	startCleanup := len(c.connections) == 1
+	c.connMutex.Unlock()
+
+	if startCleanup {
+		go c.cleanup()
+	}
+
+	return conn, nil
+}
+
+// cleanup keeps removing idle connections until there are no connections left.
+func (c *Transport) cleanup() {
+	for {
+		time.Sleep(c.IdleConnTimeout / 10)
+		if _, remaining := c.CloseIdleConnections(); remaining == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,398 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultCircuitConsecutiveFailures is the default number of consecutive failures which opens the circuit.
+	DefaultCircuitConsecutiveFailures = 5
+	// DefaultCircuitMinRequests is the default minimum number of requests in the window before the error rate is evaluated.
+	DefaultCircuitMinRequests = 10
+	// DefaultCircuitWindow is the default duration of the window in which the error rate is computed.
+	DefaultCircuitWindow = 10 * time.Second
+	// DefaultCircuitOpenTimeout is the default time after which the open circuit lets the probe requests through.
+	DefaultCircuitOpenTimeout = 30 * time.Second
+	// DefaultCircuitHalfOpenRequests is the default number of successful probe requests which close the circuit.
+	DefaultCircuitHalfOpenRequests = 1
+)
+
+// DefaultCircuitFailureStatusCodes are the HTTP status codes which are counted as failures by default.
+var DefaultCircuitFailureStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// CircuitState is the state of the circuit of the endpoint.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests with CircuitOpenError.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through in order to check whether the endpoint has recovered.
+	CircuitHalfOpen
+)
+
+func (c CircuitState) String() string {
+	switch c {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(c))
+	}
+}
+
+// CircuitBreakerOptions describes when the circuit of the endpoint is opened and closed.
+// The zero value of each field means its default value.
+type CircuitBreakerOptions struct {
+	// ConsecutiveFailures is the number of consecutive failures which opens the circuit.
+	// Set it to a negative value to open the circuit only by the error rate.
+	ConsecutiveFailures int
+
+	// ErrorRate is the ratio of the failed requests in the window (between 0 and 1) which opens the circuit.
+	// Zero disables the error rate threshold.
+	ErrorRate float64
+
+	// MinRequests is the minimum number of requests in the window before the error rate is evaluated.
+	MinRequests int
+
+	// Window is the duration of the window in which the error rate is computed.
+	Window time.Duration
+
+	// OpenTimeout is the time after which the open circuit becomes half-open.
+	OpenTimeout time.Duration
+
+	// HalfOpenRequests is the number of probe requests let through by the half-open circuit.
+	// The circuit is closed when all of them succeed, and it is opened again when any of them fails.
+	HalfOpenRequests int
+
+	// FailureStatusCodes are the HTTP status codes of the responses which are counted as failures.
+	// The network errors and timeouts are always counted as failures.
+	FailureStatusCodes []int
+
+	// OnStateChange is called when the circuit of the endpoint changes its state.
+	OnStateChange func(endpoint string, from, to CircuitState)
+}
+
+func (c *CircuitBreakerOptions) setDefaults() {
+	if c.ConsecutiveFailures == 0 {
+		c.ConsecutiveFailures = DefaultCircuitConsecutiveFailures
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = DefaultCircuitMinRequests
+	}
+	if c.Window <= 0 {
+		c.Window = DefaultCircuitWindow
+	}
+	if c.OpenTimeout <= 0 {
+		c.OpenTimeout = DefaultCircuitOpenTimeout
+	}
+	if c.HalfOpenRequests <= 0 {
+		c.HalfOpenRequests = DefaultCircuitHalfOpenRequests
+	}
+	if c.FailureStatusCodes == nil {
+		c.FailureStatusCodes = DefaultCircuitFailureStatusCodes
+	}
+}
+
+// CircuitOpenError is returned without sending the request when the circuit of the endpoint is open.
+type CircuitOpenError struct {
+	// Endpoint whose circuit is open.
+	Endpoint string
+
+	// RetryAfter is the time after which the circuit lets the probe requests through.
+	RetryAfter time.Duration
+}
+
+func (c CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit of the endpoint %s is open, retry after %s", c.Endpoint, c.RetryAfter)
+}
+
+// IsCircuitOpen returns true if the error is caused by the open circuit.
+func IsCircuitOpen(err error) bool {
+	var circuitErr CircuitOpenError
+	return errors.As(err, &circuitErr)
+}
+
+// CircuitBreaker keeps the circuits of the endpoints. The circuits are shared by all connections wrapped by the same CircuitBreaker.
+type CircuitBreaker interface {
+	// Wrap returns the connection which fails fast when the circuit of the request endpoint is open. It can be used as the Wrapper.
+	Wrap(c Connection) Connection
+
+	// State returns the state of the circuit of the endpoint.
+	State(endpoint string) CircuitState
+}
+
+// NewCircuitBreakerWrapper returns the connection wrapper with the circuit breaker per endpoint.
+// Use NewCircuitBreaker to have access to the state of the circuits.
+func NewCircuitBreakerWrapper(opts CircuitBreakerOptions) Wrapper {
+	return NewCircuitBreaker(opts).Wrap
+}
+
+// NewCircuitBreaker creates the circuit breaker per endpoint.
+//
+// The circuit is opened when the number of consecutive failures or the error rate in the window reaches its threshold.
+// The open circuit rejects the requests with CircuitOpenError until OpenTimeout elapses. Then it becomes half-open and lets
+// the probe requests through: it is closed when they succeed, or opened again when any of them fails.
+//
+// When the circuit breaker is wrapped by the failover wrapper (NewFailoverWrapper), the rejected requests are sent to another endpoint.
+func NewCircuitBreaker(opts CircuitBreakerOptions) CircuitBreaker {
+	opts.setDefaults()
+
+	return &circuitBreaker{
+		opts:     opts,
+		circuits: map[string]*circuit{},
+	}
+}
+
+type circuitBreaker struct {
+	opts CircuitBreakerOptions
+
+	lock     sync.Mutex
+	circuits map[string]*circuit
+}
+
+func (c *circuitBreaker) Wrap(conn Connection) Connection {
+	return &circuitBreakerWrapper{
+		Connection: conn,
+		breaker:    c,
+	}
+}
+
+func (c *circuitBreaker) State(endpoint string) CircuitState {
+	return c.circuit(endpoint).currentState(time.Now())
+}
+
+func (c *circuitBreaker) circuit(endpoint string) *circuit {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ci, ok := c.circuits[endpoint]
+	if !ok {
+		ci = &circuit{
+			endpoint:    endpoint,
+			opts:        &c.opts,
+			windowStart: time.Now(),
+		}
+		c.circuits[endpoint] = ci
+	}
+
+	return ci
+}
+
+// circuit is the state machine of a single endpoint.
+type circuit struct {
+	endpoint string
+	opts     *CircuitBreakerOptions
+
+	lock                sync.Mutex
+	state               CircuitState
+	openedAt            time.Time
+	consecutiveFailures int
+	windowStart         time.Time
+	windowRequests      int
+	windowFailures      int
+	probes              int
+	probeSuccesses      int
+}
+
+// currentState returns the state, taking into account that the open circuit becomes half-open after the timeout.
+func (c *circuit) currentState(now time.Time) CircuitState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.state == CircuitOpen && now.Sub(c.openedAt) >= c.opts.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// allow checks whether the request can be sent. It returns CircuitOpenError if it can not.
+func (c *circuit) allow(now time.Time) (err error) {
+	c.update(func() {
+		if c.state == CircuitOpen {
+			if wait := c.opts.OpenTimeout - now.Sub(c.openedAt); wait > 0 {
+				err = CircuitOpenError{Endpoint: c.endpoint, RetryAfter: wait}
+				return
+			}
+			c.setState(CircuitHalfOpen)
+		}
+
+		if c.state == CircuitHalfOpen {
+			if c.probes >= c.opts.HalfOpenRequests {
+				err = CircuitOpenError{Endpoint: c.endpoint}
+				return
+			}
+			c.probes++
+		}
+	})
+
+	return err
+}
+
+// report records the result of the request which has been allowed.
+func (c *circuit) report(now time.Time, failed bool) {
+	c.update(func() {
+		switch c.state {
+		case CircuitHalfOpen:
+			if failed {
+				c.open(now)
+				return
+			}
+			c.probeSuccesses++
+			if c.probeSuccesses >= c.opts.HalfOpenRequests {
+				c.setState(CircuitClosed)
+			}
+		case CircuitClosed:
+			if now.Sub(c.windowStart) >= c.opts.Window {
+				c.windowStart = now
+				c.windowRequests = 0
+				c.windowFailures = 0
+			}
+
+			c.windowRequests++
+			if !failed {
+				c.consecutiveFailures = 0
+				return
+			}
+			c.windowFailures++
+			c.consecutiveFailures++
+
+			if c.opts.ConsecutiveFailures > 0 && c.consecutiveFailures >= c.opts.ConsecutiveFailures {
+				c.open(now)
+				return
+			}
+
+			if c.opts.ErrorRate > 0 && c.windowRequests >= c.opts.MinRequests &&
+				float64(c.windowFailures)/float64(c.windowRequests) >= c.opts.ErrorRate {
+				c.open(now)
+			}
+		}
+	})
+}
+
+// cancel frees the probe slot of the request which has been allowed, but whose result is unknown.
+func (c *circuit) cancel() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.state == CircuitHalfOpen && c.probes > c.probeSuccesses {
+		c.probes--
+	}
+}
+
+// update calls the function with the lock held and notifies about the state change after the lock is released.
+func (c *circuit) update(f func()) {
+	c.lock.Lock()
+	from := c.state
+	f()
+	to := c.state
+	c.lock.Unlock()
+
+	if notify := c.opts.OnStateChange; notify != nil && from != to {
+		notify(c.endpoint, from, to)
+	}
+}
+
+func (c *circuit) open(now time.Time) {
+	c.openedAt = now
+	c.setState(CircuitOpen)
+}
+
+// setState changes the state and resets the counters. It must be called with the lock held.
+func (c *circuit) setState(state CircuitState) {
+	c.state = state
+	c.consecutiveFailures = 0
+	c.windowStart = time.Now()
+	c.windowRequests = 0
+	c.windowFailures = 0
+	c.probes = 0
+	c.probeSuccesses = 0
+}
+
+type circuitBreakerWrapper struct {
+	Connection
+
+	breaker *circuitBreaker
+}
+
+func (c *circuitBreakerWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	ci := c.breaker.circuit(request.Endpoint())
+	if err := ci.allow(time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Connection.Do(ctx, request, output, allowedStatusCodes...)
+	c.report(ci, resp, err)
+
+	return resp, err
+}
+
+func (c *circuitBreakerWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	ci := c.breaker.circuit(request.Endpoint())
+	if err := ci.allow(time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	resp, body, err := c.Connection.Stream(ctx, request)
+	c.report(ci, resp, err)
+
+	return resp, body, err
+}
+
+// report classifies the result of the request. The cancellation by the caller is neither a failure nor a success.
+func (c *circuitBreakerWrapper) report(ci *circuit, resp Response, err error) {
+	if resp != nil {
+		failed := false
+		for _, code := range c.breaker.opts.FailureStatusCodes {
+			if resp.Code() == code {
+				failed = true
+				break
+			}
+		}
+		ci.report(time.Now(), failed)
+		return
+	}
+
+	if err == nil {
+		ci.report(time.Now(), false)
+		return
+	}
+
+	if errors.Is(err, context.Canceled) {
+		ci.cancel()
+		return
+	}
+
+	ci.report(time.Now(), true)
+}
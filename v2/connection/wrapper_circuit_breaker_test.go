@@ -0,0 +1,169 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func getFromEndpoint(t *testing.T, c Connection, endpoint string) (Response, error) {
+	req, err := c.NewRequestWithEndpoint(endpoint, http.MethodGet, "_api", "version")
+	require.NoError(t, err)
+
+	return c.Do(context.Background(), req, nil)
+}
+
+func Test_CircuitBreaker_ConsecutiveFailures(t *testing.T) {
+	var lock sync.Mutex
+	var changes []CircuitState
+
+	stub := newStubConnection()
+	codes := []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}
+	stub.handler = func(_ context.Context, call int, _ Request) stubResponse {
+		return stubResponse{code: codes[call%len(codes)], body: "{}"}
+	}
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{
+		ConsecutiveFailures: 3,
+		OpenTimeout:         20 * time.Millisecond,
+		OnStateChange: func(endpoint string, from, to CircuitState) {
+			lock.Lock()
+			defer lock.Unlock()
+			require.Equal(t, "http://a", endpoint)
+			changes = append(changes, to)
+		},
+	})
+	c := breaker.Wrap(stub)
+
+	for i := 0; i < 3; i++ {
+		resp, err := getFromEndpoint(t, c, "http://a")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, resp.Code())
+	}
+	require.Equal(t, CircuitOpen, breaker.State("http://a"))
+
+	// The open circuit fails fast.
+	_, err := getFromEndpoint(t, c, "http://a")
+	require.True(t, IsCircuitOpen(err))
+	require.Equal(t, 3, stub.callCount())
+
+	// Other endpoints are not affected.
+	require.Equal(t, CircuitClosed, breaker.State("http://b"))
+
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, CircuitHalfOpen, breaker.State("http://a"))
+
+	resp, err := getFromEndpoint(t, c, "http://a")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code())
+	require.Equal(t, CircuitClosed, breaker.State("http://a"))
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitClosed}, changes)
+}
+
+func Test_CircuitBreaker_ErrorRate(t *testing.T) {
+	stub := newStubConnection()
+	stub.handler = func(_ context.Context, call int, _ Request) stubResponse {
+		if call%2 == 1 {
+			return stubResponse{err: errors.New("connection refused")}
+		}
+		return stubResponse{code: http.StatusOK, body: "{}"}
+	}
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{
+		ConsecutiveFailures: -1,
+		ErrorRate:           0.5,
+		MinRequests:         4,
+	})
+	c := breaker.Wrap(stub)
+
+	for i := 0; i < 4; i++ {
+		require.Equal(t, CircuitClosed, breaker.State("http://a"))
+		getFromEndpoint(t, c, "http://a")
+	}
+	require.Equal(t, CircuitOpen, breaker.State("http://a"))
+
+	_, err := getFromEndpoint(t, c, "http://a")
+	require.True(t, IsCircuitOpen(errors.WithStack(err)))
+	require.True(t, IsCircuitOpen(errors.Wrap(err, "request failed")))
+
+	var circuitErr CircuitOpenError
+	require.True(t, errors.As(err, &circuitErr))
+	require.Equal(t, "http://a", circuitErr.Endpoint)
+	require.Greater(t, circuitErr.RetryAfter, time.Duration(0))
+}
+
+func Test_CircuitBreaker_HalfOpen(t *testing.T) {
+	unblock := make(chan struct{})
+
+	stub := newStubConnection()
+	stub.handler = func(ctx context.Context, call int, _ Request) stubResponse {
+		switch call {
+		case 0, 1:
+			return stubResponse{code: http.StatusGatewayTimeout, body: "{}"}
+		case 2:
+			<-unblock
+			return stubResponse{err: context.Canceled}
+		default:
+			return stubResponse{code: http.StatusBadGateway, body: "{}"}
+		}
+	}
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{ConsecutiveFailures: 2, OpenTimeout: 10 * time.Millisecond})
+	c := breaker.Wrap(stub)
+
+	getFromEndpoint(t, c, "http://a")
+	getFromEndpoint(t, c, "http://a")
+	require.Equal(t, CircuitOpen, breaker.State("http://a"))
+	time.Sleep(20 * time.Millisecond)
+
+	// Only one probe is let through by the half-open circuit.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		getFromEndpoint(t, c, "http://a")
+	}()
+	require.Eventually(t, func() bool {
+		return stub.callCount() == 3
+	}, time.Second, time.Millisecond)
+
+	_, err := getFromEndpoint(t, c, "http://a")
+	require.True(t, IsCircuitOpen(err))
+
+	// The cancelled probe frees its slot, and the failed probe opens the circuit again.
+	close(unblock)
+	<-done
+	require.Equal(t, CircuitHalfOpen, breaker.State("http://a"))
+
+	resp, err := getFromEndpoint(t, c, "http://a")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadGateway, resp.Code())
+	require.Equal(t, CircuitOpen, breaker.State("http://a"))
+}
@@ -0,0 +1,61 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+// NewContextLoggerWrapper returns the connection wrapper which logs the messages of the requests with the given logger,
+// unless the context of the request already contains the logger set with log.WithLogger.
+// It is used to attach the logger to the client, when several clients share the configuration of the connection.
+func NewContextLoggerWrapper(logger log.Log) Wrapper {
+	return func(c Connection) Connection {
+		return &contextLoggerWrapper{
+			Connection: c,
+			logger:     logger,
+		}
+	}
+}
+
+type contextLoggerWrapper struct {
+	Connection
+
+	logger log.Log
+}
+
+func (c *contextLoggerWrapper) withLogger(ctx context.Context) context.Context {
+	if log.FromContext(ctx) != nil {
+		return ctx
+	}
+	return log.WithLogger(ctx, c.logger)
+}
+
+func (c *contextLoggerWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	return c.Connection.Do(c.withLogger(ctx), request, output, allowedStatusCodes...)
+}
+
+func (c *contextLoggerWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	return c.Connection.Stream(c.withLogger(ctx), request)
+}
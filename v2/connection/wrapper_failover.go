@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NewFailoverWrapper returns the connection wrapper which reports the outcome of each request to the health-aware endpoint,
+// and fails over the request to another healthy endpoint when it fails with a network error or with 503.
+//
+// The request is failed over only if it is safe to send it again (GET and HEAD requests, and requests whose
+// context is marked with WithIdempotent), and its endpoint has not been requested explicitly with NewRequestWithEndpoint.
+// The endpoint must be the one used by the wrapped connection.
+//
+// Only the requests of the HTTP connections (NewHttpConnection, NewHttp2Connection) are failed over.
+// The requests of the VST connection (NewVSTConnection) are sent once to their endpoint, without an error or a log entry,
+// and their outcome is still reported to the endpoint.
+func NewFailoverWrapper(endpoint HealthAwareEndpoint) Wrapper {
+	return func(c Connection) Connection {
+		return &failoverWrapper{
+			Connection: c,
+			endpoint:   endpoint,
+		}
+	}
+}
+
+type failoverWrapper struct {
+	Connection
+
+	endpoint HealthAwareEndpoint
+}
+
+func (w *failoverWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	resp, body, err := w.Stream(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+
+	return DecodeStream(w.Decoder(resp.Content()), resp, body, output, allowedStatusCodes...)
+}
+
+// Stream performs HTTP request and fails it over to another endpoint if needed.
+// It returns the response and body reader to read the data from there.
+// The caller is responsible to free the response body.
+func (w *failoverWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	ctx = contextOrBackground(ctx)
+
+	// The VST requests are not failed over, see NewFailoverWrapper.
+	req, canFailover := request.(*httpRequest)
+	canFailover = canFailover && !req.pinned && isRetrySafe(ctx, request)
+
+	var tried []string
+	for {
+		start := time.Now()
+		resp, body, err := w.Connection.Stream(ctx, request)
+
+		failure := err
+		if err == nil && resp.Code() == http.StatusServiceUnavailable {
+			failure = errors.Errorf("endpoint responded with %d", resp.Code())
+		}
+
+		switch {
+		case failure == nil:
+			w.endpoint.ReportSuccess(request.Endpoint(), time.Since(start))
+			return resp, body, nil
+		case ctx.Err() != nil:
+			// The request has been canceled by the caller, so it says nothing about the endpoint.
+			return resp, body, err
+		}
+
+		w.endpoint.ReportFailure(request.Endpoint(), failure)
+		if !canFailover {
+			return resp, body, err
+		}
+
+		tried = append(tried, request.Endpoint())
+		next, nextErr := w.endpoint.Next(tried...)
+		if nextErr != nil {
+			return resp, body, err
+		}
+
+		if body != nil {
+			// Discard the data.
+			body.Close()
+		}
+
+		if request, err = req.withEndpoint(next); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+}
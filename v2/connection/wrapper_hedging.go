@@ -0,0 +1,269 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHedgingDelay is the default time after which the hedged request is sent to another endpoint.
+	DefaultHedgingDelay = 100 * time.Millisecond
+	// DefaultHedgingMaxHedges is the default number of additional requests sent for a single request.
+	DefaultHedgingMaxHedges = 1
+)
+
+// HedgingOptions describes when the hedged requests are sent by the connection wrapper created with NewHedgingWrapper.
+// The zero value of each field means its default value.
+type HedgingOptions struct {
+	// Delay is the time after which the request is sent to the next endpoint if no response has been received.
+	Delay time.Duration
+
+	// MaxHedges is the maximum number of additional requests sent to other endpoints for a single request.
+	MaxHedges int
+
+	// OnHedge is called when the hedged request is sent to the endpoint, e.g. for metrics.
+	OnHedge func(request Request, endpoint string)
+}
+
+func (h *HedgingOptions) setDefaults() {
+	if h.Delay <= 0 {
+		h.Delay = DefaultHedgingDelay
+	}
+	if h.MaxHedges <= 0 {
+		h.MaxHedges = DefaultHedgingMaxHedges
+	}
+}
+
+// NewHedgingWrapper returns the connection wrapper which sends the same read-only request to another endpoint
+// when the first endpoint has not responded within the delay. The first response wins and the other requests are cancelled.
+// The request is sent to the next endpoint immediately when the previous one fails with a network error.
+//
+// GET and HEAD requests are hedged, as well as the requests whose context is marked with WithHedgeable.
+// Other requests, e.g. POST requests which create AQL cursors, are never hedged unless they are marked.
+// The request is not hedged if its endpoint has been requested explicitly with NewRequestWithEndpoint, e.g. when reading
+// the next batch of the cursor (CallWithEndpoint), if it is sent to an existing cursor (`/_api/cursor/{id}`),
+// or if its body is a reader, which can be read only once.
+// The endpoints are taken from Endpoint.List() of the wrapped connection.
+func NewHedgingWrapper(opts HedgingOptions) Wrapper {
+	opts.setDefaults()
+
+	return func(c Connection) Connection {
+		return &hedgingWrapper{
+			Connection: c,
+			opts:       opts,
+		}
+	}
+}
+
+type hedgingWrapper struct {
+	Connection
+
+	opts HedgingOptions
+}
+
+func (h *hedgingWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	resp, body, err := h.Stream(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+
+	return DecodeStream(h.Decoder(resp.Content()), resp, body, output, allowedStatusCodes...)
+}
+
+// Stream performs the request and hedges it if needed.
+// The caller is responsible to free the response body.
+func (h *hedgingWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	ctx = contextOrBackground(ctx)
+
+	req, ok := request.(*httpRequest)
+	if !ok || req.pinned || !isHedgeable(ctx, req) {
+		return h.Connection.Stream(ctx, request)
+	}
+
+	endpoints := h.hedgeEndpoints(request.Endpoint())
+	if len(endpoints) == 0 {
+		return h.Connection.Stream(ctx, request)
+	}
+
+	return h.hedge(ctx, req, endpoints)
+}
+
+// hedgeEndpoints returns the endpoints to which the request can be hedged, in the order of Endpoint.List()
+// starting after the endpoint of the request.
+func (h *hedgingWrapper) hedgeEndpoints(current string) []string {
+	list := h.GetEndpoint().List()
+
+	start := 0
+	for i, e := range list {
+		if e == current {
+			start = i + 1
+			break
+		}
+	}
+
+	var endpoints []string
+	for i := 0; i < len(list) && len(endpoints) < h.opts.MaxHedges; i++ {
+		if e := list[(start+i)%len(list)]; e != current && !contains(endpoints, e) {
+			endpoints = append(endpoints, e)
+		}
+	}
+
+	return endpoints
+}
+
+type hedgeResult struct {
+	index int
+	resp  Response
+	body  io.ReadCloser
+	err   error
+}
+
+func (h *hedgingWrapper) hedge(ctx context.Context, req *httpRequest, endpoints []string) (Response, io.ReadCloser, error) {
+	// Each attempt is a copy of the request, because the connection adds the headers to the request which it sends.
+	attempts := make([]*httpRequest, 0, len(endpoints)+1)
+	for _, endpoint := range append([]string{req.endpoint}, endpoints...) {
+		attempt, err := req.withEndpoint(endpoint)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	results := make(chan hedgeResult, len(attempts))
+
+	var cancels []context.CancelFunc
+	send := func(request Request) {
+		attemptCtx, cancel := context.WithCancel(ctx)
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+
+		go func() {
+			resp, body, err := h.Connection.Stream(attemptCtx, request)
+			results <- hedgeResult{index: index, resp: resp, body: body, err: err}
+		}()
+	}
+
+	pending := 0
+	// cancelOthers cancels all requests except the winner, and frees the responses which are received later.
+	cancelOthers := func(winner int) {
+		for i, cancel := range cancels {
+			if i != winner {
+				cancel()
+			}
+		}
+		go discardHedgeResults(results, pending)
+	}
+
+	send(attempts[0])
+	pending++
+
+	timer := time.NewTimer(h.opts.Delay)
+	defer timer.Stop()
+
+	var last hedgeResult
+	for {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				// The first response wins. The context of the winner is cancelled when its body is closed.
+				cancelOthers(r.index)
+				if r.body == nil {
+					cancels[r.index]()
+					return r.resp, nil, nil
+				}
+				return r.resp, &cancelOnClose{ReadCloser: r.body, cancel: cancels[r.index]}, nil
+			}
+
+			cancels[r.index]()
+			last = r
+			if ctx.Err() != nil {
+				cancelOthers(-1)
+				return nil, nil, last.err
+			}
+		case <-timer.C:
+		case <-ctx.Done():
+			cancelOthers(-1)
+			return nil, nil, errors.WithStack(ctx.Err())
+		}
+
+		// The delay has elapsed or the request has failed, so the request is sent to the next endpoint.
+		if next := len(cancels); next < len(attempts) {
+			if h.opts.OnHedge != nil {
+				h.opts.OnHedge(attempts[next], attempts[next].endpoint)
+			}
+
+			send(attempts[next])
+			pending++
+			timer.Reset(h.opts.Delay)
+		} else if pending == 0 {
+			return last.resp, last.body, last.err
+		}
+	}
+}
+
+// discardHedgeResults frees the responses of the cancelled requests.
+func discardHedgeResults(results <-chan hedgeResult, count int) {
+	for i := 0; i < count; i++ {
+		if r := <-results; r.body != nil {
+			dropBodyData(r.body)
+		}
+	}
+}
+
+// isHedgeable returns true if the request is read-only, so it can be sent to many endpoints at once.
+func isHedgeable(ctx context.Context, request *httpRequest) bool {
+	if _, ok := request.body.(io.Reader); ok {
+		return false
+	}
+
+	// The cursor exists only on the coordinator which has created it.
+	if strings.Contains(request.url.Path, "/_api/cursor/") {
+		return false
+	}
+
+	switch request.Method() {
+	case http.MethodGet, http.MethodHead:
+		return true
+	default:
+		return IsHedgeableRequest(ctx)
+	}
+}
+
+// cancelOnClose cancels the context of the request when the body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+
+	return c.ReadCloser.Close()
+}
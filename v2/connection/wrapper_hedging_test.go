@@ -0,0 +1,213 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// newHedgingStub returns the connection to the endpoints "http://a" and "http://b".
+// The requests to "http://a" are handled by the slow function, and the requests to "http://b" respond immediately.
+func newHedgingStub(slow func(ctx context.Context) stubResponse) (*stubConnection, *sync.Map) {
+	cancelled := &sync.Map{}
+
+	stub := newStubConnection()
+	stub.endpoint = NewRoundRobinEndpoints([]string{"http://a", "http://b"})
+	stub.handler = func(ctx context.Context, _ int, request Request) stubResponse {
+		if strings.HasPrefix(request.URL(), "http://a") {
+			r := slow(ctx)
+			if ctx.Err() != nil {
+				cancelled.Store(request.Endpoint(), true)
+			}
+			return r
+		}
+		return stubResponse{code: http.StatusOK, body: `{"from":"b"}`}
+	}
+
+	return stub, cancelled
+}
+
+func blockUntilCancelled(ctx context.Context) stubResponse {
+	select {
+	case <-ctx.Done():
+		return stubResponse{err: ctx.Err()}
+	case <-time.After(5 * time.Second):
+		return stubResponse{code: http.StatusOK, body: `{"from":"a"}`}
+	}
+}
+
+func Test_HedgingWrapper_SlowEndpoint(t *testing.T) {
+	stub, cancelled := newHedgingStub(blockUntilCancelled)
+
+	var hedged []string
+	c := NewHedgingWrapper(HedgingOptions{
+		Delay: 20 * time.Millisecond,
+		OnHedge: func(_ Request, endpoint string) {
+			hedged = append(hedged, endpoint)
+		},
+	})(stub)
+
+	var out struct {
+		From string `json:"from"`
+	}
+	resp, err := CallGet(context.Background(), c, NewUrl("_api", "version"), &out)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code())
+	require.Equal(t, "b", out.From)
+	require.Equal(t, "http://b", resp.Endpoint())
+	require.Equal(t, []string{"http://b"}, hedged)
+
+	// The loser is cancelled.
+	require.Eventually(t, func() bool {
+		_, ok := cancelled.Load("http://a")
+		return ok
+	}, time.Second, time.Millisecond)
+	require.Equal(t, 2, stub.callCount())
+}
+
+func Test_HedgingWrapper_FailedEndpoint(t *testing.T) {
+	stub, _ := newHedgingStub(func(_ context.Context) stubResponse {
+		return stubResponse{err: errors.New("connection refused")}
+	})
+	c := NewHedgingWrapper(HedgingOptions{Delay: time.Minute})(stub)
+
+	req, err := c.NewRequest(http.MethodGet, "_api", "version")
+	require.NoError(t, err)
+	require.Equal(t, "http://a", req.Endpoint())
+
+	// The request is hedged immediately after the failure.
+	start := time.Now()
+	resp, body, err := c.Stream(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "http://b", resp.Endpoint())
+	require.Less(t, time.Since(start), time.Second)
+
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+	require.Equal(t, `{"from":"b"}`, string(data))
+}
+
+func Test_HedgingWrapper_AllFailed(t *testing.T) {
+	stub := newStubConnection(stubResponse{err: errors.New("connection refused")})
+	stub.endpoint = NewRoundRobinEndpoints([]string{"http://a", "http://b", "http://c"})
+	c := NewHedgingWrapper(HedgingOptions{Delay: time.Minute, MaxHedges: 5})(stub)
+
+	_, err := CallGet(context.Background(), c, NewUrl("_api", "version"), nil)
+	require.EqualError(t, err, "connection refused")
+	require.Equal(t, 3, stub.callCount())
+}
+
+func Test_HedgingWrapper_NotHedgeable(t *testing.T) {
+	stub, _ := newHedgingStub(func(_ context.Context) stubResponse {
+		time.Sleep(30 * time.Millisecond)
+		return stubResponse{code: http.StatusCreated, body: `{"from":"a"}`}
+	})
+	c := NewHedgingWrapper(HedgingOptions{Delay: time.Millisecond})(stub)
+
+	t.Run("POST", func(t *testing.T) {
+		req, err := c.NewRequest(http.MethodPost, "_api", "cursor")
+		require.NoError(t, err)
+
+		resp, err := c.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://a", resp.Endpoint())
+	})
+
+	t.Run("Pinned", func(t *testing.T) {
+		req, err := c.NewRequestWithEndpoint("http://a", http.MethodGet, "_api", "cursor", "123")
+		require.NoError(t, err)
+
+		resp, err := c.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://a", resp.Endpoint())
+	})
+
+	t.Run("Hedgeable POST", func(t *testing.T) {
+		req, err := c.NewRequest(http.MethodPost, "_api", "cursor")
+		require.NoError(t, err)
+		if req.Endpoint() != "http://a" {
+			// Round-robin moves to the other endpoint on each request.
+			req, err = c.NewRequest(http.MethodPost, "_api", "cursor")
+			require.NoError(t, err)
+		}
+
+		resp, err := c.Do(WithHedgeable(context.Background()), req, nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://b", resp.Endpoint())
+	})
+}
+
+func Test_HedgingWrapper_NotHedgeableBody(t *testing.T) {
+	stub, _ := newHedgingStub(func(_ context.Context) stubResponse {
+		time.Sleep(30 * time.Millisecond)
+		return stubResponse{code: http.StatusOK, body: `{"from":"a"}`}
+	})
+	c := NewHedgingWrapper(HedgingOptions{Delay: time.Millisecond})(stub)
+
+	req, err := c.NewRequestWithEndpoint("", http.MethodGet, "_api", "version")
+	require.NoError(t, err)
+	if req.Endpoint() != "http://a" {
+		req, err = c.NewRequestWithEndpoint("", http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+	}
+	require.NoError(t, req.SetBody(strings.NewReader(`{}`)))
+
+	resp, err := c.Do(context.Background(), req, nil)
+	require.NoError(t, err)
+	require.Equal(t, "http://a", resp.Endpoint())
+}
+
+func Test_HedgingWrapper_Headers(t *testing.T) {
+	stub, _ := newHedgingStub(func(ctx context.Context) stubResponse {
+		return blockUntilCancelled(ctx)
+	})
+	handler := stub.handler
+	stub.handler = func(ctx context.Context, i int, request Request) stubResponse {
+		// The connection adds the headers to the request while the next attempt is prepared.
+		for j := 0; j < 100; j++ {
+			request.AddHeader("X-Attempt", request.Endpoint())
+		}
+		return handler(ctx, i, request)
+	}
+	c := NewHedgingWrapper(HedgingOptions{Delay: time.Millisecond})(stub)
+
+	req, err := c.NewRequest(http.MethodGet, "_api", "version")
+	require.NoError(t, err)
+	req.AddHeader("X-Original", "1")
+
+	resp, err := c.Do(context.Background(), req, nil)
+	require.NoError(t, err)
+	require.Equal(t, "http://b", resp.Endpoint())
+
+	// The original request is not sent.
+	_, ok := req.GetHeader("X-Attempt")
+	require.False(t, ok)
+}
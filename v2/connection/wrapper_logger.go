@@ -0,0 +1,414 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// DefaultLoggerMaxBodySize is the default maximum number of bytes of the captured request and response bodies.
+const DefaultLoggerMaxBodySize = 1024
+
+// RequestLogEntry describes the completed request logged by the connection wrapper created with NewLoggerWrapper.
+type RequestLogEntry struct {
+	// RequestID is taken from the context set with WithRequestID or it is generated.
+	RequestID string
+	Method    string
+	URL       string
+	// Endpoint which has handled the request. It is empty if the request has failed before it was sent.
+	Endpoint   string
+	StatusCode int
+	Duration   time.Duration
+	// RequestBody and ResponseBody are set only if the body capture is enabled.
+	// Passwords, secrets, tokens and JWTs are redacted.
+	RequestBody  string
+	ResponseBody string
+	Err          error
+}
+
+// RequestLogger receives the entries of the completed requests.
+type RequestLogger interface {
+	LogRequest(ctx context.Context, entry RequestLogEntry)
+}
+
+// LoggerOptions configures the connection wrapper created with NewLoggerWrapper.
+type LoggerOptions struct {
+	// Logger receives the entries. It is required.
+	Logger RequestLogger
+
+	// CaptureBody enables the capture of the request and response bodies.
+	// The bodies of VelocyPack requests and responses are not captured, only their size is logged.
+	CaptureBody bool
+
+	// MaxBodySize is the maximum number of bytes of the captured body, after which the body is truncated.
+	// The zero value means DefaultLoggerMaxBodySize and a negative value disables the truncation.
+	MaxBodySize int
+
+	// RequestIDHeader is the name of the header in which the request ID is sent to the server, e.g. to correlate
+	// the entries with the logs of the proxy. The ID is not sent if it is empty.
+	RequestIDHeader string
+}
+
+func (l *LoggerOptions) setDefaults() {
+	if l.MaxBodySize == 0 {
+		l.MaxBodySize = DefaultLoggerMaxBodySize
+	}
+}
+
+// NewLoggerWrapper returns the connection wrapper which logs each request with its ID, status code and duration.
+// The request of the streamed response is logged when the response body is closed.
+func NewLoggerWrapper(opts LoggerOptions) Wrapper {
+	opts.setDefaults()
+
+	return func(c Connection) Connection {
+		return &loggerWrapper{
+			Connection: c,
+			opts:       opts,
+		}
+	}
+}
+
+type loggerWrapper struct {
+	Connection
+
+	opts LoggerOptions
+}
+
+func (l *loggerWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	ctx, entry := l.start(ctx, request)
+
+	resp, body, err := l.Connection.Stream(ctx, request)
+	if err != nil {
+		l.finish(ctx, entry, resp, err)
+		return resp, err
+	}
+
+	var captured *bodyCapture
+	if l.opts.CaptureBody {
+		captured = newBodyCapture(body, l.opts.MaxBodySize)
+		body = captured
+	}
+
+	resp, err = DecodeStream(l.Decoder(resp.Content()), resp, body, output, allowedStatusCodes...)
+	if captured != nil {
+		entry.ResponseBody = captured.String(resp.Content())
+	}
+	l.finish(ctx, entry, resp, err)
+
+	return resp, err
+}
+
+// Stream performs the request and logs it when the response body is closed.
+// The caller is responsible to free the response body.
+func (l *loggerWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	ctx, entry := l.start(ctx, request)
+
+	resp, body, err := l.Connection.Stream(ctx, request)
+	if err != nil || body == nil {
+		l.finish(ctx, entry, resp, err)
+		return resp, body, err
+	}
+
+	var captured *bodyCapture
+	if l.opts.CaptureBody {
+		captured = newBodyCapture(body, l.opts.MaxBodySize)
+		body = captured
+	}
+
+	return resp, &logOnClose{ReadCloser: body, log: func() {
+		if captured != nil {
+			entry.ResponseBody = captured.String(resp.Content())
+		}
+		l.finish(ctx, entry, resp, nil)
+	}}, nil
+}
+
+// loggedRequest is the entry of the request in progress.
+type loggedRequest struct {
+	RequestLogEntry
+
+	started time.Time
+}
+
+func (l *loggerWrapper) start(ctx context.Context, request Request) (context.Context, *loggedRequest) {
+	ctx = contextOrBackground(ctx)
+
+	id, ok := HasRequestID(ctx)
+	if !ok || id == "" {
+		id = uuid.New().String()
+		ctx = WithRequestID(ctx, id)
+	}
+
+	if l.opts.RequestIDHeader != "" {
+		request.AddHeader(l.opts.RequestIDHeader, id)
+	}
+
+	entry := &loggedRequest{
+		RequestLogEntry: RequestLogEntry{
+			RequestID: id,
+			Method:    request.Method(),
+			URL:       request.URL(),
+		},
+		started: time.Now(),
+	}
+
+	if l.opts.CaptureBody {
+		if getter, ok := request.(interface{ GetBody() interface{} }); ok {
+			entry.RequestBody = requestBodyForLog(getter.GetBody(), l.opts.MaxBodySize)
+		}
+	}
+
+	return ctx, entry
+}
+
+func (l *loggerWrapper) finish(ctx context.Context, entry *loggedRequest, resp Response, err error) {
+	entry.Duration = time.Since(entry.started)
+	entry.Err = err
+	if resp != nil {
+		entry.Endpoint = resp.Endpoint()
+		entry.StatusCode = resp.Code()
+	}
+
+	l.opts.Logger.LogRequest(ctx, entry.RequestLogEntry)
+}
+
+// logOnClose logs the request when the body is closed.
+type logOnClose struct {
+	io.ReadCloser
+
+	once sync.Once
+	log  func()
+}
+
+func (l *logOnClose) Close() error {
+	defer l.once.Do(l.log)
+
+	return l.ReadCloser.Close()
+}
+
+// bodyCaptureMargin is the number of bytes which are captured after the limit, so the secrets cut off at the limit
+// are complete and can be redacted before the body is truncated.
+const bodyCaptureMargin = 256
+
+// bodyCapture keeps the first bytes of the body which has been read.
+type bodyCapture struct {
+	io.ReadCloser
+
+	limit  int
+	size   int
+	buffer bytes.Buffer
+	// cut is set if the body is longer than the captured bytes.
+	cut bool
+}
+
+func newBodyCapture(body io.ReadCloser, limit int) *bodyCapture {
+	return &bodyCapture{ReadCloser: body, limit: limit}
+}
+
+func (b *bodyCapture) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += n
+
+	data := p[:n]
+	if capacity := b.limit + bodyCaptureMargin; b.limit >= 0 && b.buffer.Len()+len(data) > capacity {
+		data = data[:capacity-b.buffer.Len()]
+		b.cut = true
+	}
+	b.buffer.Write(data)
+
+	return n, err
+}
+
+// String returns the redacted body. The VelocyPack body is replaced with its size.
+func (b *bodyCapture) String(contentType string) string {
+	if contentType == ApplicationVPack {
+		return vpackBodyForLog(b.size)
+	}
+
+	body := redactBodyForLog(b.buffer.String())
+	if b.cut {
+		// The last value can be a secret which is longer than the margin.
+		body = redactIncompleteBodyForLog(body)
+	}
+
+	if b.limit >= 0 && len(body) > b.limit {
+		return truncateBodyForLog(body[:b.limit], true)
+	}
+	return truncateBodyForLog(body, b.cut)
+}
+
+func requestBodyForLog(body interface{}, limit int) string {
+	switch v := body.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return redactAndTruncate(string(v), limit)
+	case string:
+		return redactAndTruncate(v, limit)
+	case io.Reader:
+		// The reader can not be consumed here.
+		return "<stream>"
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+
+	return redactAndTruncate(string(data), limit)
+}
+
+func redactAndTruncate(body string, limit int) string {
+	body = redactBodyForLog(body)
+	if limit >= 0 && len(body) > limit {
+		return truncateBodyForLog(body[:limit], true)
+	}
+	return body
+}
+
+func truncateBodyForLog(body string, truncated bool) string {
+	if truncated {
+		return body + "...(truncated)"
+	}
+	return body
+}
+
+func vpackBodyForLog(size int) string {
+	return "<vpack " + strconv.Itoa(size) + " bytes>"
+}
+
+var (
+	// redactedFieldPattern matches the JSON string fields whose names contain password, secret, token or jwt.
+	redactedFieldPattern = regexp.MustCompile(`(?i)("[^"]*(?:password|passwd|secret|token|jwt)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// redactedJWTPattern matches the JWTs in any place of the body.
+	redactedJWTPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+
+	// redactedIncompleteFieldPattern matches the unterminated value of the redacted field at the end of the cut body.
+	redactedIncompleteFieldPattern = regexp.MustCompile(`(?i)("[^"]*(?:password|passwd|secret|token|jwt)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*\\?$`)
+	// redactedIncompleteJWTPattern matches the incomplete JWT at the end of the cut body.
+	redactedIncompleteJWTPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*(?:\.[A-Za-z0-9_-]*){0,2}$`)
+)
+
+// redactBodyForLog hides the passwords, secrets, tokens and JWTs of the body.
+func redactBodyForLog(body string) string {
+	body = redactedFieldPattern.ReplaceAllString(body, `${1}"***"`)
+	return redactedJWTPattern.ReplaceAllString(body, "***")
+}
+
+// redactIncompleteBodyForLog hides the secret or the JWT which has been cut off at the end of the body.
+func redactIncompleteBodyForLog(body string) string {
+	body = redactedIncompleteFieldPattern.ReplaceAllString(body, `${1}"***`)
+	return redactedIncompleteJWTPattern.ReplaceAllString(body, "***")
+}
+
+// NewSlogRequestLogger returns the RequestLogger which writes the entries to the slog.Logger.
+// The failed requests and the server errors are logged with the error level, the others with the debug level.
+func NewSlogRequestLogger(logger *slog.Logger) RequestLogger {
+	return slogRequestLogger{logger: logger}
+}
+
+type slogRequestLogger struct {
+	logger *slog.Logger
+}
+
+func (s slogRequestLogger) LogRequest(ctx context.Context, entry RequestLogEntry) {
+	level := slog.LevelDebug
+	if isFailedLogEntry(entry) {
+		level = slog.LevelError
+	}
+
+	if !s.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("request_id", entry.RequestID),
+		slog.String("method", entry.Method),
+		slog.String("url", entry.URL),
+		slog.String("endpoint", entry.Endpoint),
+		slog.Int("status", entry.StatusCode),
+		slog.Duration("duration", entry.Duration),
+	}
+	if entry.RequestBody != "" {
+		attrs = append(attrs, slog.String("request_body", entry.RequestBody))
+	}
+	if entry.ResponseBody != "" {
+		attrs = append(attrs, slog.String("response_body", entry.ResponseBody))
+	}
+	if entry.Err != nil {
+		attrs = append(attrs, slog.String("error", entry.Err.Error()))
+	}
+
+	s.logger.LogAttrs(ctx, level, "ArangoDB request", attrs...)
+}
+
+// NewZeroLogRequestLogger returns the RequestLogger which writes the entries to the zerolog.Logger.
+// The failed requests and the server errors are logged with the error level, the others with the debug level.
+func NewZeroLogRequestLogger(logger zerolog.Logger) RequestLogger {
+	return zeroLogRequestLogger{logger: logger}
+}
+
+type zeroLogRequestLogger struct {
+	logger zerolog.Logger
+}
+
+func (z zeroLogRequestLogger) LogRequest(_ context.Context, entry RequestLogEntry) {
+	event := z.logger.Debug()
+	if isFailedLogEntry(entry) {
+		event = z.logger.Error().Err(entry.Err)
+	}
+
+	if event == nil {
+		return
+	}
+
+	event = event.
+		Str("request_id", entry.RequestID).
+		Str("method", entry.Method).
+		Str("url", entry.URL).
+		Str("endpoint", entry.Endpoint).
+		Int("status", entry.StatusCode).
+		Dur("duration", entry.Duration)
+	if entry.RequestBody != "" {
+		event = event.Str("request_body", entry.RequestBody)
+	}
+	if entry.ResponseBody != "" {
+		event = event.Str("response_body", entry.ResponseBody)
+	}
+
+	event.Msg("ArangoDB request")
+}
+
+func isFailedLogEntry(entry RequestLogEntry) bool {
+	return entry.Err != nil || entry.StatusCode >= http.StatusInternalServerError
+}
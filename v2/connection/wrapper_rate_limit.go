@@ -0,0 +1,337 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RateLimitKeyFunc returns the key of the limiter which is used for the request.
+// The requests with different keys are limited independently.
+type RateLimitKeyFunc func(r Request) string
+
+// RateLimitByEndpoint limits the requests independently for each endpoint.
+func RateLimitByEndpoint(r Request) string {
+	return r.Endpoint()
+}
+
+// RateLimitByDatabase limits the requests independently for each database.
+// The database is taken from the `/_db/<name>/` prefix of the request path. The `_system` database is used if there is no prefix.
+func RateLimitByDatabase(r Request) string {
+	return requestDatabase(r)
+}
+
+// RateLimitByEndpointAndDatabase limits the requests independently for each pair of the endpoint and the database.
+func RateLimitByEndpointAndDatabase(r Request) string {
+	return fmt.Sprintf("%s/_db/%s", RateLimitByEndpoint(r), RateLimitByDatabase(r))
+}
+
+// RateLimitOptions describes the limits of the connection wrapper created with NewRateLimiter.
+type RateLimitOptions struct {
+	// RequestsPerSecond is the rate at which the tokens are added to the bucket. Zero disables the rate limiting.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of tokens in the bucket, so the number of requests which can be sent at once.
+	// The default is the rate rounded up, but at least 1.
+	Burst int
+
+	// MaxInFlight is the maximum number of requests which are sent concurrently. Zero disables the concurrency limiting.
+	// The request is in flight until its response is decoded, or the body of the streamed response is closed.
+	MaxInFlight int
+
+	// Key returns the key of the limiter for the request, e.g. RateLimitByEndpoint or RateLimitByDatabase.
+	// If it is nil, all requests share a single limiter.
+	Key RateLimitKeyFunc
+}
+
+// RateLimitStats contains the state of the limiter of a single key.
+type RateLimitStats struct {
+	// Key of the limiter. It is empty if RateLimitOptions.Key is not set.
+	Key string
+
+	// Waiting is the number of requests which are waiting for the token or for the in-flight slot.
+	Waiting int
+
+	// InFlight is the number of requests which are being sent.
+	InFlight int
+}
+
+// RateLimiter limits the rate and the concurrency of the requests sent over the wrapped connections.
+// The limits are shared by all connections wrapped by the same RateLimiter.
+type RateLimiter interface {
+	// Wrap returns the connection which waits for the limiter before each request. It can be used as the Wrapper.
+	Wrap(c Connection) Connection
+
+	// QueueDepth returns the number of requests which are waiting for the limiter.
+	QueueDepth() int
+
+	// Stats returns the state of the limiters, sorted by the key.
+	Stats() []RateLimitStats
+}
+
+// NewRateLimitWrapper returns the connection wrapper which limits the requests according to the given options.
+// Use NewRateLimiter to have access to the queue depth.
+func NewRateLimitWrapper(opts RateLimitOptions) Wrapper {
+	return NewRateLimiter(opts).Wrap
+}
+
+// NewRateLimiter creates the limiter which enforces the token bucket request rate and the maximum number of requests in flight.
+//
+// The waiting requests respect the context cancellation. When ArangoDBConfiguration.ArangoQueueTimeoutEnabled is set
+// and the queue timeout is taken from the context deadline, the `x-arango-queue-time-seconds` header is recalculated
+// after the wait, so the server does not get more time than is left for the request.
+func NewRateLimiter(opts RateLimitOptions) RateLimiter {
+	if opts.Burst <= 0 {
+		opts.Burst = int(math.Max(1, math.Ceil(opts.RequestsPerSecond)))
+	}
+
+	return &rateLimiter{
+		opts:     opts,
+		limiters: map[string]*keyLimiter{},
+	}
+}
+
+type rateLimiter struct {
+	opts RateLimitOptions
+
+	lock     sync.Mutex
+	limiters map[string]*keyLimiter
+}
+
+func (r *rateLimiter) Wrap(c Connection) Connection {
+	return &rateLimitWrapper{
+		Connection: c,
+		limiter:    r,
+	}
+}
+
+func (r *rateLimiter) QueueDepth() int {
+	depth := 0
+	for _, s := range r.Stats() {
+		depth += s.Waiting
+	}
+	return depth
+}
+
+func (r *rateLimiter) Stats() []RateLimitStats {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	stats := make([]RateLimitStats, 0, len(r.limiters))
+	for key, l := range r.limiters {
+		stats = append(stats, RateLimitStats{
+			Key:      key,
+			Waiting:  int(l.waiting.Load()),
+			InFlight: int(l.inFlight.Load()),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Key < stats[j].Key
+	})
+
+	return stats
+}
+
+// limiter returns the limiter for the request.
+func (r *rateLimiter) limiter(request Request) *keyLimiter {
+	var key string
+	if r.opts.Key != nil {
+		key = r.opts.Key(request)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	l, ok := r.limiters[key]
+	if !ok {
+		l = &keyLimiter{
+			rate:   r.opts.RequestsPerSecond,
+			burst:  float64(r.opts.Burst),
+			tokens: float64(r.opts.Burst),
+			last:   time.Now(),
+		}
+		if r.opts.MaxInFlight > 0 {
+			l.slots = make(chan struct{}, r.opts.MaxInFlight)
+		}
+		r.limiters[key] = l
+	}
+
+	return l
+}
+
+// keyLimiter is the token bucket and the semaphore of a single key.
+type keyLimiter struct {
+	waiting  atomic.Int32
+	inFlight atomic.Int32
+
+	// slots is the semaphore of the requests in flight. It is nil if the concurrency is not limited.
+	slots chan struct{}
+
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// acquire waits for the in-flight slot and for the token. The returned function releases the slot.
+func (l *keyLimiter) acquire(ctx context.Context) (func(), error) {
+	l.waiting.Add(1)
+	defer l.waiting.Add(-1)
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, errors.WithStack(ctx.Err())
+		}
+	}
+
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if err := l.waitForToken(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	l.inFlight.Add(1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.inFlight.Add(-1)
+			release()
+		})
+	}, nil
+}
+
+// waitForToken takes the token from the bucket. If the bucket is empty, the token is reserved
+// and the caller waits until it is available. The reservation is cancelled when the context is done.
+func (l *keyLimiter) waitForToken(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.lock.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give back the reserved token.
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+		return errors.WithStack(ctx.Err())
+	}
+}
+
+type rateLimitWrapper struct {
+	Connection
+
+	limiter *rateLimiter
+}
+
+func (r *rateLimitWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	release, err := r.acquire(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return r.Connection.Do(ctx, request, output, allowedStatusCodes...)
+}
+
+// Stream performs the request when the limiter allows it.
+// The request stays in flight until the returned body is closed.
+func (r *rateLimitWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	release, err := r.acquire(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, body, err := r.Connection.Stream(ctx, request)
+	if err != nil || body == nil {
+		release()
+		return resp, body, err
+	}
+
+	return resp, &releaseOnClose{ReadCloser: body, release: release}, nil
+}
+
+func (r *rateLimitWrapper) acquire(ctx context.Context, request Request) (func(), error) {
+	ctx = contextOrBackground(ctx)
+
+	release, err := r.limiter.limiter(request).acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The queue timeout taken from the context deadline has been computed before the wait, so it is updated.
+	config := r.GetConfiguration()
+	if deadline, ok := ctx.Deadline(); ok && config.ArangoQueueTimeoutEnabled && config.ArangoQueueTimeoutSec == 0 {
+		if _, ok := request.GetHeader("x-arango-queue-time-seconds"); ok {
+			request.AddHeader("x-arango-queue-time-seconds", fmt.Sprint(time.Until(deadline).Seconds()))
+		}
+	}
+
+	return release, nil
+}
+
+// releaseOnClose calls the release function when the body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+
+	return r.ReadCloser.Close()
+}
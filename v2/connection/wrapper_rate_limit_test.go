@@ -0,0 +1,155 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RateLimitByDatabase(t *testing.T) {
+	c := newStubConnection(stubResponse{code: http.StatusOK})
+
+	r, err := c.NewRequest(http.MethodGet, "_db", "test", "_api", "version")
+	require.NoError(t, err)
+	require.Equal(t, "test", RateLimitByDatabase(r))
+	require.Equal(t, "http://stub/_db/test", RateLimitByEndpointAndDatabase(r))
+
+	r, err = c.NewRequest(http.MethodGet, "_api", "version")
+	require.NoError(t, err)
+	require.Equal(t, "_system", RateLimitByDatabase(r))
+}
+
+func Test_RateLimitWrapper_MaxInFlight(t *testing.T) {
+	unblock := make(chan struct{})
+	started := make(chan struct{}, 10)
+
+	stub := newStubConnection()
+	stub.handler = func(_ context.Context, _ int, _ Request) stubResponse {
+		started <- struct{}{}
+		<-unblock
+		return stubResponse{code: http.StatusOK, body: "{}"}
+	}
+
+	limiter := NewRateLimiter(RateLimitOptions{MaxInFlight: 1})
+	c := limiter.Wrap(stub)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := CallGet(context.Background(), c, NewUrl("_api", "version"), nil)
+			assert.NoError(t, err)
+		}()
+	}
+
+	<-started
+	require.Eventually(t, func() bool {
+		return limiter.QueueDepth() == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []RateLimitStats{{Waiting: 1, InFlight: 1}}, limiter.Stats())
+
+	// The waiting request is cancelled by its context.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := CallGet(ctx, c, NewUrl("_api", "version"), nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(unblock)
+	wg.Wait()
+	require.Equal(t, 2, stub.callCount())
+	require.Equal(t, 0, limiter.QueueDepth())
+	require.Equal(t, []RateLimitStats{{}}, limiter.Stats())
+}
+
+func Test_RateLimitWrapper_Stream(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitOptions{MaxInFlight: 1})
+	c := limiter.Wrap(newStubConnection(stubResponse{code: http.StatusOK, body: "{}"}))
+
+	req, err := c.NewRequest(http.MethodGet, "_api", "version")
+	require.NoError(t, err)
+
+	_, body, err := c.Stream(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, limiter.Stats()[0].InFlight)
+
+	// The slot is kept until the body is closed.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = c.Stream(ctx, req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, body.Close())
+	require.NoError(t, body.Close())
+	require.Equal(t, 0, limiter.Stats()[0].InFlight)
+
+	_, body, err = c.Stream(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+}
+
+func Test_RateLimitWrapper_Rate(t *testing.T) {
+	stub := newStubConnection(stubResponse{code: http.StatusOK, body: "{}"})
+	c := NewRateLimitWrapper(RateLimitOptions{RequestsPerSecond: 20, Burst: 1, Key: RateLimitByDatabase})(stub)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := CallGet(context.Background(), c, NewUrl("_db", "a", "_api", "version"), nil)
+		require.NoError(t, err)
+	}
+	// The first request uses the burst, the next ones wait 50ms each.
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Other database has its own bucket.
+	start = time.Now()
+	_, err := CallGet(context.Background(), c, NewUrl("_db", "b", "_api", "version"), nil)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 40*time.Millisecond)
+}
+
+func Test_RateLimitWrapper_QueueTimeout(t *testing.T) {
+	stub := newStubConnection(stubResponse{code: http.StatusOK, body: "{}"})
+	stub.config = ArangoDBConfiguration{ArangoQueueTimeoutEnabled: true}
+	c := NewRateLimitWrapper(RateLimitOptions{RequestsPerSecond: 10, Burst: 1})(stub)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for i := 0; i < 2; i++ {
+		_, err := CallGet(ctx, c, NewUrl("_api", "version"), nil)
+		require.NoError(t, err)
+	}
+
+	// The second request waited for 100ms, so the queue timeout is reduced.
+	value, ok := stub.requests[1].GetHeader("x-arango-queue-time-seconds")
+	require.True(t, ok)
+	timeout, err := strconv.ParseFloat(value, 64)
+	require.NoError(t, err)
+	require.Less(t, timeout, 0.95)
+}
@@ -26,6 +26,8 @@ import (
 	"net/http"
 )
 
+// RetryOn503 returns the connection which retries the requests immediately when the server responds with 503.
+// For the retries with backoff see NewRetryPolicyWrapper.
 func RetryOn503(conn Connection, retries int) Connection {
 	return NewRetryWrapper(conn, retries, func(response Response, err error) bool {
 		if err != nil {
@@ -36,6 +38,8 @@ func RetryOn503(conn Connection, retries int) Connection {
 	})
 }
 
+// NewRetryWrapper returns the connection which retries the requests immediately, as long as the wrapper returns true.
+// For the retries with backoff, time budget and idempotency checks see NewRetryPolicyWrapper.
 func NewRetryWrapper(conn Connection, retries int, wrapper RetryWrapper) Connection {
 	return &retryWrapper{
 		wrapper:    wrapper,
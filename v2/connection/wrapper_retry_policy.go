@@ -0,0 +1,343 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default maximum number of attempts of the request, including the first one.
+	DefaultRetryMaxAttempts = 5
+	// DefaultRetryInitialBackoff is the default delay before the first retry.
+	DefaultRetryInitialBackoff = 100 * time.Millisecond
+	// DefaultRetryMaxBackoff is the default maximum delay between two attempts.
+	DefaultRetryMaxBackoff = 5 * time.Second
+	// DefaultRetryMultiplier is the default factor by which the delay grows after each attempt.
+	DefaultRetryMultiplier = 2.0
+	// DefaultRetryJitter is the default fraction of the delay which is randomized.
+	DefaultRetryJitter = 0.2
+)
+
+// DefaultRetryStatusCodes are the HTTP status codes which are retried by default.
+var DefaultRetryStatusCodes = []int{http.StatusServiceUnavailable}
+
+// DefaultRetryErrorNums are the ArangoDB error numbers which are retried by default:
+// read-only/write-locked (1004), write-write conflict (1200) and shard leader change (1496).
+var DefaultRetryErrorNums = []int{shared.ErrArangoReadOnly, shared.ErrArangoConflict, shared.ErrClusterNotLeader}
+
+// RetryPolicy describes when and how often the requests are retried by the connection wrapper created with NewRetryPolicyWrapper.
+// The zero value of each field means its default value.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of the request, including the first one.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two attempts. It does not limit the delay requested by the server
+	// with the `Retry-After` header.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor by which the delay grows after each attempt.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay (between 0 and 1) which is randomized in order to spread the retries of many clients.
+	// Set it to a negative value to disable the jitter.
+	Jitter float64
+
+	// TimeBudget is the maximum total time spent on the request, including all attempts and delays.
+	// The retry is not made if it would exceed the budget. Zero means that only the context deadline is taken into account.
+	TimeBudget time.Duration
+
+	// RetryStatusCodes are the HTTP status codes of the responses which are retried.
+	RetryStatusCodes []int
+
+	// RetryErrorNums are the ArangoDB error numbers of the responses which are retried.
+	RetryErrorNums []int
+
+	// OnAttempt is called after each attempt, e.g. for logging.
+	OnAttempt func(attempt RetryAttempt)
+}
+
+// RetryAttempt describes the result of a single attempt of the request.
+type RetryAttempt struct {
+	// Attempt is the number of the attempt, starting from 1.
+	Attempt int
+
+	// Request is the request which has been sent.
+	Request Request
+
+	// Response of the attempt. It is nil when the request failed before the response was received.
+	Response Response
+
+	// ErrorNum is the ArangoDB error number of the response, if any.
+	ErrorNum int
+
+	// Err is the error of the attempt, if any.
+	Err error
+
+	// Elapsed is the time elapsed since the first attempt was started.
+	Elapsed time.Duration
+
+	// Retry is true when the request will be retried after Delay.
+	Retry bool
+
+	// Delay is the time to wait before the next attempt.
+	Delay time.Duration
+}
+
+// NewRetryPolicyWrapper returns the connection wrapper which retries the failed requests according to the given policy.
+//
+// The request is retried only if it is safe to send it again: GET and HEAD requests, and other requests
+// whose context is marked with WithIdempotent. The request is retried when:
+//   - it fails with a network error,
+//   - the response has one of the RetryPolicy.RetryStatusCodes,
+//   - the response has one of the RetryPolicy.RetryErrorNums.
+//
+// Between the attempts the wrapper waits with the exponential backoff and jitter. The `Retry-After` header of the response
+// takes precedence over the computed delay. The wrapper stops retrying when the context is done or the time budget is exceeded.
+//
+// The body of the retried request is encoded again for each attempt, so it must not be an io.Reader.
+func NewRetryPolicyWrapper(policy RetryPolicy) Wrapper {
+	policy.setDefaults()
+
+	return func(c Connection) Connection {
+		return &retryPolicyWrapper{
+			Connection: c,
+			policy:     policy,
+		}
+	}
+}
+
+func (p *RetryPolicy) setDefaults() {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultRetryMaxAttempts
+	}
+
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = DefaultRetryInitialBackoff
+	}
+
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = DefaultRetryMaxBackoff
+	}
+
+	if p.Multiplier < 1 {
+		p.Multiplier = DefaultRetryMultiplier
+	}
+
+	if p.Jitter == 0 {
+		p.Jitter = DefaultRetryJitter
+	} else if p.Jitter < 0 {
+		p.Jitter = 0
+	} else if p.Jitter > 1 {
+		p.Jitter = 1
+	}
+
+	if p.RetryStatusCodes == nil {
+		p.RetryStatusCodes = DefaultRetryStatusCodes
+	}
+
+	if p.RetryErrorNums == nil {
+		p.RetryErrorNums = DefaultRetryErrorNums
+	}
+}
+
+// backoff returns the delay before the next attempt, after the given number of attempts.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		delay -= delay * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+type retryPolicyWrapper struct {
+	Connection
+
+	policy RetryPolicy
+}
+
+func (w *retryPolicyWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	resp, body, err := w.Stream(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+
+	return DecodeStream(w.Decoder(resp.Content()), resp, body, output, allowedStatusCodes...)
+}
+
+// Stream performs HTTP request and retries it according to the policy.
+// It returns the response and body reader to read the data from there.
+// The caller is responsible to free the response body.
+func (w *retryPolicyWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	ctx = contextOrBackground(ctx)
+	retryable := isRetrySafe(ctx, request)
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		resp, body, err := w.Connection.Stream(ctx, request)
+
+		info := RetryAttempt{
+			Attempt:  attempt,
+			Request:  request,
+			Response: resp,
+			Err:      err,
+		}
+
+		var retryAfter time.Duration
+		if err == nil && resp.Code() >= http.StatusBadRequest {
+			// The error responses are small, so they are buffered to inspect the ArangoDB error number.
+			var data []byte
+			data, err = io.ReadAll(body)
+			body.Close()
+			body = io.NopCloser(bytes.NewReader(data))
+
+			var respStruct shared.Response
+			if w.Decoder(resp.Content()).Decode(bytes.NewReader(data), &respStruct) == nil && respStruct.ErrorNum != nil {
+				info.ErrorNum = *respStruct.ErrorNum
+			}
+			retryAfter = parseRetryAfter(resp.Header("Retry-After"), time.Now())
+		}
+
+		info.Err = err
+		info.Elapsed = time.Since(start)
+		if retryable && attempt < w.policy.MaxAttempts && w.shouldRetry(ctx, info) {
+			info.Delay = w.policy.backoff(attempt)
+			if retryAfter > 0 {
+				info.Delay = retryAfter
+			}
+			info.Retry = w.withinBudget(ctx, info.Elapsed, info.Delay)
+		}
+
+		if w.policy.OnAttempt != nil {
+			w.policy.OnAttempt(info)
+		}
+
+		if !info.Retry {
+			if err != nil {
+				if body != nil {
+					body.Close()
+				}
+				return resp, nil, err
+			}
+			return resp, body, nil
+		}
+
+		if body != nil {
+			// Discard the data.
+			body.Close()
+		}
+
+		timer := time.NewTimer(info.Delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, errors.WithStack(ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func (w *retryPolicyWrapper) shouldRetry(ctx context.Context, attempt RetryAttempt) bool {
+	if attempt.Err != nil {
+		// The context errors are final, other errors come from the transport.
+		return ctx.Err() == nil && !errors.Is(attempt.Err, context.Canceled) && !errors.Is(attempt.Err, context.DeadlineExceeded)
+	}
+
+	for _, code := range w.policy.RetryStatusCodes {
+		if attempt.Response.Code() == code {
+			return true
+		}
+	}
+
+	if attempt.ErrorNum != 0 {
+		for _, num := range w.policy.RetryErrorNums {
+			if attempt.ErrorNum == num {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// withinBudget returns true if the next attempt can be started after the delay,
+// without exceeding the time budget and the deadline of the context.
+func (w *retryPolicyWrapper) withinBudget(ctx context.Context, elapsed, delay time.Duration) bool {
+	if w.policy.TimeBudget > 0 && elapsed+delay > w.policy.TimeBudget {
+		return false
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+
+	return true
+}
+
+// isRetrySafe returns true if the request can be sent again without side effects.
+func isRetrySafe(ctx context.Context, request Request) bool {
+	switch request.Method() {
+	case http.MethodGet, http.MethodHead:
+		return true
+	default:
+		return IsIdempotentRequest(ctx)
+	}
+}
+
+// parseRetryAfter parses the value of the `Retry-After` header, which is either the number of seconds or the HTTP date.
+// It returns zero if the value is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
@@ -0,0 +1,218 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func newRetryPolicyTestConnection(policy RetryPolicy, responses ...stubResponse) (Connection, *stubConnection) {
+	stub := newStubConnection(responses...)
+	return NewRetryPolicyWrapper(policy)(stub), stub
+}
+
+func Test_RetryPolicyWrapper(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+		Jitter:         -1,
+	}
+
+	unavailable := stubResponse{code: http.StatusServiceUnavailable, body: `{"error":true,"code":503,"errorNum":503}`}
+	conflict := stubResponse{code: http.StatusConflict, body: `{"error":true,"code":409,"errorNum":1200}`}
+	notFound := stubResponse{code: http.StatusNotFound, body: `{"error":true,"code":404,"errorNum":1202}`}
+	ok := stubResponse{code: http.StatusOK, body: `{"value":"ok"}`}
+
+	t.Run("GET is retried until success", func(t *testing.T) {
+		conn, stub := newRetryPolicyTestConnection(policy, unavailable, conflict, ok)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		var output struct {
+			Value string `json:"value"`
+		}
+		resp, err := conn.Do(context.Background(), req, &output)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code())
+		assert.Equal(t, "ok", output.Value)
+		assert.Equal(t, 3, stub.callCount())
+	})
+
+	t.Run("not retryable error is returned immediately", func(t *testing.T) {
+		conn, stub := newRetryPolicyTestConnection(policy, notFound, ok)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "document", "col", "key")
+		require.NoError(t, err)
+
+		_, err = conn.Do(context.Background(), req, nil, http.StatusOK)
+		require.True(t, shared.IsArangoErrorWithErrorNum(err, shared.ErrArangoDocumentNotFound))
+		assert.Equal(t, 1, stub.callCount())
+	})
+
+	t.Run("error body is available after the last attempt", func(t *testing.T) {
+		p := policy
+		p.MaxAttempts = 2
+		conn, stub := newRetryPolicyTestConnection(p, conflict)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		var output shared.ResponseStruct
+		resp, err := conn.Do(context.Background(), req, &output)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, resp.Code())
+		require.NotNil(t, output.ErrorNum)
+		assert.Equal(t, shared.ErrArangoConflict, *output.ErrorNum)
+		assert.Equal(t, 2, stub.callCount())
+	})
+
+	t.Run("write is not retried unless it is idempotent", func(t *testing.T) {
+		conn, stub := newRetryPolicyTestConnection(policy, unavailable, unavailable, ok)
+
+		req, err := conn.NewRequest(http.MethodPost, "_api", "document", "col")
+		require.NoError(t, err)
+
+		resp, err := conn.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+		assert.Equal(t, 1, stub.callCount())
+
+		resp, err = conn.Do(WithIdempotent(context.Background()), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code())
+		assert.Equal(t, 3, stub.callCount())
+	})
+
+	t.Run("network error is retried", func(t *testing.T) {
+		conn, stub := newRetryPolicyTestConnection(policy, stubResponse{err: errors.New("connection reset")}, ok)
+
+		req, err := conn.NewRequest(http.MethodHead, "_api", "version")
+		require.NoError(t, err)
+
+		_, err = conn.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stub.callCount())
+	})
+
+	t.Run("attempts are reported and limited", func(t *testing.T) {
+		var attempts []RetryAttempt
+		p := policy
+		p.MaxAttempts = 3
+		p.OnAttempt = func(attempt RetryAttempt) {
+			attempts = append(attempts, attempt)
+		}
+		conn, stub := newRetryPolicyTestConnection(p, conflict)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		_, err = conn.Do(context.Background(), req, nil, http.StatusOK)
+		require.True(t, shared.IsArangoErrorWithErrorNum(err, shared.ErrArangoConflict))
+		assert.Equal(t, 3, stub.callCount())
+
+		require.Len(t, attempts, 3)
+		for i, attempt := range attempts {
+			assert.Equal(t, i+1, attempt.Attempt)
+			assert.Equal(t, shared.ErrArangoConflict, attempt.ErrorNum)
+			assert.Equal(t, i < 2, attempt.Retry)
+		}
+		assert.Equal(t, time.Millisecond, attempts[0].Delay)
+		assert.Equal(t, 2*time.Millisecond, attempts[1].Delay)
+	})
+
+	t.Run("Retry-After header is honored", func(t *testing.T) {
+		var delays []time.Duration
+		p := policy
+		p.OnAttempt = func(attempt RetryAttempt) {
+			delays = append(delays, attempt.Delay)
+		}
+		withRetryAfter := stubResponse{code: http.StatusServiceUnavailable, headers: map[string]string{"Retry-After": "1"}}
+		conn, _ := newRetryPolicyTestConnection(p, withRetryAfter, ok)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		// The deadline of the context does not allow to wait for the time requested by the server.
+		resp, err := conn.Do(ctx, req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+		assert.Equal(t, []time.Duration{time.Second}, delays)
+	})
+
+	t.Run("time budget stops the retries", func(t *testing.T) {
+		p := policy
+		p.MaxAttempts = 100
+		p.InitialBackoff = 10 * time.Millisecond
+		p.MaxBackoff = 10 * time.Millisecond
+		p.TimeBudget = 35 * time.Millisecond
+		conn, stub := newRetryPolicyTestConnection(p, unavailable)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		resp, err := conn.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+		assert.LessOrEqual(t, stub.callCount(), 4)
+		assert.GreaterOrEqual(t, stub.callCount(), 2)
+	})
+
+	t.Run("canceled context stops the retries", func(t *testing.T) {
+		p := policy
+		p.InitialBackoff = time.Minute
+		p.MaxBackoff = time.Minute
+		conn, stub := newRetryPolicyTestConnection(p, unavailable)
+
+		req, err := conn.NewRequest(http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		_, err = conn.Do(ctx, req, nil)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, stub.callCount())
+	})
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-3", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Mon, 01 Jan 2024 10:01:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Mon, 01 Jan 2024 09:00:00 GMT", now))
+}
@@ -0,0 +1,151 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// stubResponse is the response returned by the stubConnection.
+type stubResponse struct {
+	code    int
+	body    string
+	headers map[string]string
+	err     error
+}
+
+// stubConnection is the connection which returns the prepared responses instead of sending the requests.
+type stubConnection struct {
+	Connection
+
+	// endpoint is used to choose the endpoint of the request, if it is set.
+	endpoint Endpoint
+
+	config ArangoDBConfiguration
+
+	lock sync.Mutex
+	// handler returns the response for the request. It is called with the number of the call, starting from 0.
+	handler func(ctx context.Context, call int, request Request) stubResponse
+	calls   int
+	// requests contains the requests in the order they were received.
+	requests []Request
+}
+
+func newStubConnection(responses ...stubResponse) *stubConnection {
+	return &stubConnection{
+		handler: func(_ context.Context, call int, _ Request) stubResponse {
+			if call < len(responses) {
+				return responses[call]
+			}
+			return responses[len(responses)-1]
+		},
+	}
+}
+
+func (s *stubConnection) NewRequest(method string, urls ...string) (Request, error) {
+	return s.newRequest("", method, urls...)
+}
+
+func (s *stubConnection) NewRequestWithEndpoint(endpoint string, method string, urls ...string) (Request, error) {
+	return s.newRequest(endpoint, method, urls...)
+}
+
+func (s *stubConnection) newRequest(endpoint string, method string, urls ...string) (*httpRequest, error) {
+	e := endpoint
+	if s.endpoint != nil {
+		var err error
+		if e, err = s.endpoint.Get(endpoint, method, path.Join(urls...)); err != nil {
+			return nil, err
+		}
+	} else if e == "" {
+		e = "http://stub"
+	}
+
+	u, err := url.Parse(e + "/" + path.Join(urls...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpRequest{method: method, url: u, endpoint: e, pinned: endpoint != "", headers: map[string]string{}}, nil
+}
+
+func (s *stubConnection) Do(ctx context.Context, request Request, output interface{}, _ ...int) (Response, error) {
+	resp, body, err := s.Stream(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+	defer body.Close()
+
+	if output != nil {
+		if err := s.Decoder(resp.Content()).Decode(body, output); err != nil && err != io.EOF {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *stubConnection) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	s.lock.Lock()
+	call := s.calls
+	s.calls++
+	s.requests = append(s.requests, request)
+	s.lock.Unlock()
+
+	r := s.handler(ctx, call, request)
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	response := &http.Response{StatusCode: r.code, Header: http.Header{}}
+	response.Header.Set(ContentType, ApplicationJSON)
+	for k, v := range r.headers {
+		response.Header.Set(k, v)
+	}
+
+	req, _ := request.(*httpRequest)
+	return &httpResponse{response: response, request: req}, io.NopCloser(strings.NewReader(r.body)), nil
+}
+
+func (s *stubConnection) Decoder(_ string) Decoder {
+	return getJsonDecoder()
+}
+
+func (s *stubConnection) GetEndpoint() Endpoint {
+	return s.endpoint
+}
+
+func (s *stubConnection) GetConfiguration() ArangoDBConfiguration {
+	return s.config
+}
+
+func (s *stubConnection) callCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.calls
+}
@@ -0,0 +1,46 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package log
+
+import "context"
+
+type contextKey string
+
+const keyLogger contextKey = "arangodb-logger"
+
+// WithLogger returns the context with the logger, which is used by the connections for the requests with this context.
+// It takes precedence over the logger of the connection and the global logger.
+func WithLogger(parent context.Context, l Log) context.Context {
+	if parent == nil {
+		parent = context.Background()
+	}
+	return context.WithValue(parent, keyLogger, l)
+}
+
+// FromContext returns the logger set with WithLogger. It returns nil if there is no logger in the context.
+func FromContext(ctx context.Context) Log {
+	if ctx != nil {
+		if l, ok := ctx.Value(keyLogger).(Log); ok {
+			return l
+		}
+	}
+	return nil
+}
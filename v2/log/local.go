@@ -22,56 +22,145 @@
 
 package log
 
-var logger Log
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// global is the logger set with SetLogger and its version.
+// The version is changed each time the global logger is set, so the loggers derived from it can be refreshed.
+type global struct {
+	log     Log
+	version uint64
+}
+
+var (
+	globalState atomic.Pointer[global]
+	globalLock  sync.Mutex
+)
 
 func SetLogger(l Log) {
-	logger = l
+	globalLock.Lock()
+	defer globalLock.Unlock()
+
+	var version uint64
+	if current := globalState.Load(); current != nil {
+		version = current.version
+	}
+	globalState.Store(&global{log: l, version: version + 1})
+}
+
+// globalLogger returns the global logger and its version.
+func globalLogger() (Log, uint64) {
+	if current := globalState.Load(); current != nil {
+		return current.log, current.version
+	}
+	return nil, 0
+}
+
+// currentLogger returns the global logger, or nil if it has not been set.
+func currentLogger() Log {
+	l, _ := globalLogger()
+	return l
+}
+
+// IsGlobalSet returns true if the global logger has been set with SetLogger.
+func IsGlobalSet() bool {
+	return currentLogger() != nil
+}
+
+// OrGlobal returns the given logger if it is not nil. Returns the global logger set with SetLogger otherwise.
+// The returned global logger forwards the messages to the logger which is set at the moment of logging.
+func OrGlobal(l Log) Log {
+	if l != nil {
+		return l
+	}
+	return globalLog{}
+}
+
+// globalLog forwards the messages to the global logger.
+type globalLog struct{}
+
+func (globalLog) Trace(msg string) {
+	Trace(msg)
+}
+
+func (globalLog) Tracef(msg string, args ...interface{}) {
+	Tracef(msg, args...)
+}
+
+func (globalLog) Debug(msg string) {
+	Debug(msg)
+}
+
+func (globalLog) Debugf(msg string, args ...interface{}) {
+	Debugf(msg, args...)
+}
+
+func (globalLog) Info(msg string) {
+	Info(msg)
+}
+
+func (globalLog) Infof(msg string, args ...interface{}) {
+	Infof(msg, args...)
+}
+
+func (globalLog) Error(err error, msg string) {
+	Error(err, msg)
+}
+
+func (globalLog) Errorf(err error, msg string, args ...interface{}) {
+	Errorf(err, msg, args...)
+}
+
+func (globalLog) With(keysAndValues ...interface{}) Log {
+	return With(nil, keysAndValues...)
 }
 
 func Trace(msg string) {
-	if logger != nil {
-		logger.Trace(msg)
+	if l := currentLogger(); l != nil {
+		l.Trace(msg)
 	}
 }
 
 func Tracef(msg string, args ...interface{}) {
-	if logger != nil {
-		logger.Tracef(msg, args...)
+	if l := currentLogger(); l != nil {
+		l.Tracef(msg, args...)
 	}
 }
 
 func Debug(msg string) {
-	if logger != nil {
-		logger.Debug(msg)
+	if l := currentLogger(); l != nil {
+		l.Debug(msg)
 	}
 }
 
 func Debugf(msg string, args ...interface{}) {
-	if logger != nil {
-		logger.Debugf(msg, args...)
+	if l := currentLogger(); l != nil {
+		l.Debugf(msg, args...)
 	}
 }
 
 func Info(msg string) {
-	if logger != nil {
-		logger.Info(msg)
+	if l := currentLogger(); l != nil {
+		l.Info(msg)
 	}
 }
 
 func Infof(msg string, args ...interface{}) {
-	if logger != nil {
-		logger.Infof(msg, args...)
+	if l := currentLogger(); l != nil {
+		l.Infof(msg, args...)
 	}
 }
 
 func Error(err error, msg string) {
-	if logger != nil {
-		logger.Error(err, msg)
+	if l := currentLogger(); l != nil {
+		l.Error(err, msg)
 	}
 }
 
 func Errorf(err error, msg string, args ...interface{}) {
-	if logger != nil {
-		logger.Errorf(err, msg, args...)
+	if l := currentLogger(); l != nil {
+		l.Errorf(err, msg, args...)
 	}
 }
@@ -22,6 +22,12 @@
 
 package log
 
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
 type Log interface {
 	Trace(msg string)
 	Tracef(msg string, args ...interface{})
@@ -35,3 +41,176 @@ type Log interface {
 	Error(err error, msg string)
 	Errorf(err error, msg string, args ...interface{})
 }
+
+// FieldLog is the Log which supports structured fields, e.g. the endpoint or the request ID.
+type FieldLog interface {
+	Log
+
+	// With returns the logger which adds the given key-value pairs to each message.
+	With(keysAndValues ...interface{}) Log
+}
+
+// With returns the logger which adds the given key-value pairs to each message.
+// If the logger does not implement FieldLog, the fields are appended to the messages.
+// If the logger is nil, the global logger is used.
+func With(l Log, keysAndValues ...interface{}) Log {
+	if len(keysAndValues) == 0 {
+		return OrGlobal(l)
+	}
+
+	switch l.(type) {
+	case nil, globalLog:
+		// The global logger can be changed later, so it is resolved when the messages are logged.
+		return &fieldsLog{fields: keysAndValues}
+	default:
+		return withFields(l, keysAndValues)
+	}
+}
+
+// withFields returns the logger which adds the fields to the messages of the given logger.
+func withFields(l Log, keysAndValues []interface{}) Log {
+	if f, ok := l.(FieldLog); ok {
+		return f.With(keysAndValues...)
+	}
+
+	return &suffixLog{parent: l, suffix: formatFields(keysAndValues)}
+}
+
+// fieldsLog adds the fields to the messages of the global logger.
+// The logger with the fields is created again only when the global logger has been changed with SetLogger.
+type fieldsLog struct {
+	fields   []interface{}
+	resolved atomic.Pointer[resolvedLog]
+}
+
+// resolvedLog is the logger with the fields for the given version of the global logger.
+type resolvedLog struct {
+	version uint64
+	log     Log
+}
+
+func (f *fieldsLog) With(keysAndValues ...interface{}) Log {
+	fields := make([]interface{}, 0, len(f.fields)+len(keysAndValues))
+	fields = append(append(fields, f.fields...), keysAndValues...)
+
+	return &fieldsLog{fields: fields}
+}
+
+func (f *fieldsLog) resolve() Log {
+	l, version := globalLogger()
+	if l == nil {
+		return nil
+	}
+
+	if r := f.resolved.Load(); r != nil && r.version == version {
+		return r.log
+	}
+
+	r := &resolvedLog{version: version, log: withFields(l, f.fields)}
+	f.resolved.Store(r)
+	return r.log
+}
+
+func (f *fieldsLog) Trace(msg string) {
+	if l := f.resolve(); l != nil {
+		l.Trace(msg)
+	}
+}
+
+func (f *fieldsLog) Tracef(msg string, args ...interface{}) {
+	if l := f.resolve(); l != nil {
+		l.Tracef(msg, args...)
+	}
+}
+
+func (f *fieldsLog) Debug(msg string) {
+	if l := f.resolve(); l != nil {
+		l.Debug(msg)
+	}
+}
+
+func (f *fieldsLog) Debugf(msg string, args ...interface{}) {
+	if l := f.resolve(); l != nil {
+		l.Debugf(msg, args...)
+	}
+}
+
+func (f *fieldsLog) Info(msg string) {
+	if l := f.resolve(); l != nil {
+		l.Info(msg)
+	}
+}
+
+func (f *fieldsLog) Infof(msg string, args ...interface{}) {
+	if l := f.resolve(); l != nil {
+		l.Infof(msg, args...)
+	}
+}
+
+func (f *fieldsLog) Error(err error, msg string) {
+	if l := f.resolve(); l != nil {
+		l.Error(err, msg)
+	}
+}
+
+func (f *fieldsLog) Errorf(err error, msg string, args ...interface{}) {
+	if l := f.resolve(); l != nil {
+		l.Errorf(err, msg, args...)
+	}
+}
+
+// suffixLog appends the formatted fields to the messages of the logger which does not support fields.
+type suffixLog struct {
+	parent Log
+	suffix string
+}
+
+func (s *suffixLog) With(keysAndValues ...interface{}) Log {
+	return &suffixLog{parent: s.parent, suffix: s.suffix + formatFields(keysAndValues)}
+}
+
+func (s *suffixLog) Trace(msg string) {
+	s.parent.Trace(msg + s.suffix)
+}
+
+func (s *suffixLog) Tracef(msg string, args ...interface{}) {
+	s.parent.Trace(fmt.Sprintf(msg, args...) + s.suffix)
+}
+
+func (s *suffixLog) Debug(msg string) {
+	s.parent.Debug(msg + s.suffix)
+}
+
+func (s *suffixLog) Debugf(msg string, args ...interface{}) {
+	s.parent.Debug(fmt.Sprintf(msg, args...) + s.suffix)
+}
+
+func (s *suffixLog) Info(msg string) {
+	s.parent.Info(msg + s.suffix)
+}
+
+func (s *suffixLog) Infof(msg string, args ...interface{}) {
+	s.parent.Info(fmt.Sprintf(msg, args...) + s.suffix)
+}
+
+func (s *suffixLog) Error(err error, msg string) {
+	s.parent.Error(err, msg+s.suffix)
+}
+
+func (s *suffixLog) Errorf(err error, msg string, args ...interface{}) {
+	s.parent.Error(err, fmt.Sprintf(msg, args...)+s.suffix)
+}
+
+// formatFields formats the key-value pairs as " key=value key=value".
+func formatFields(keysAndValues []interface{}) string {
+	var b strings.Builder
+	for i := 0; i < len(keysAndValues); i += 2 {
+		b.WriteString(" ")
+		b.WriteString(fmt.Sprint(keysAndValues[i]))
+		b.WriteString("=")
+		if i+1 < len(keysAndValues) {
+			b.WriteString(fmt.Sprint(keysAndValues[i+1]))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,177 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingLog struct {
+	messages []string
+}
+
+func (r *recordingLog) Trace(msg string)                       { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Tracef(msg string, args ...interface{}) { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Debug(msg string)                       { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Debugf(msg string, args ...interface{}) { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Info(msg string)                        { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Infof(msg string, args ...interface{})  { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Error(err error, msg string)            { r.messages = append(r.messages, msg) }
+func (r *recordingLog) Errorf(err error, msg string, args ...interface{}) {
+	r.messages = append(r.messages, msg)
+}
+
+func decodeLines(t *testing.T, data []byte) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		var fields map[string]interface{}
+		require.NoError(t, json.Unmarshal(line, &fields))
+		result = append(result, fields)
+	}
+	return result
+}
+
+func Test_SlogLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	l := NewSlogLogger(slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: LevelTrace})))
+
+	With(l, "endpoint", "http://a").Tracef("trace %d", 1)
+	With(l, "database", "db").Errorf(errors.New("failed"), "error %s", "x")
+
+	lines := decodeLines(t, buffer.Bytes())
+	require.Len(t, lines, 2)
+	assert.Equal(t, "trace 1", lines[0]["msg"])
+	assert.Equal(t, "http://a", lines[0]["endpoint"])
+	assert.Equal(t, "error x", lines[1]["msg"])
+	assert.Equal(t, "ERROR", lines[1]["level"])
+	assert.Equal(t, "db", lines[1]["database"])
+	assert.Equal(t, "failed", lines[1]["error"])
+}
+
+func Test_ZeroLogLogger_With(t *testing.T) {
+	var buffer bytes.Buffer
+	l := NewZeroLogLogger(zerolog.New(&buffer))
+
+	With(l, "request_id", "id").Info("message")
+
+	lines := decodeLines(t, buffer.Bytes())
+	require.Len(t, lines, 1)
+	assert.Equal(t, "message", lines[0]["message"])
+	assert.Equal(t, "id", lines[0]["request_id"])
+}
+
+func Test_With_UnstructuredLogger(t *testing.T) {
+	r := &recordingLog{}
+
+	With(With(r, "a", 1), "b", "c").Infof("message %d", 1)
+
+	assert.Equal(t, []string{"message 1 a=1 b=c"}, r.messages)
+}
+
+// countingFieldLog counts the loggers created with With.
+type countingFieldLog struct {
+	recordingLog
+	with int
+}
+
+func (c *countingFieldLog) With(keysAndValues ...interface{}) Log {
+	c.with++
+	return &suffixLog{parent: &c.recordingLog, suffix: formatFields(keysAndValues)}
+}
+
+func Test_With_ResolvedOnce(t *testing.T) {
+	defer SetLogger(currentLogger())
+
+	own := &countingFieldLog{}
+	l := With(own, "a", 1)
+	l.Info("first")
+	l.Debugf("second")
+	assert.Equal(t, 1, own.with)
+	assert.Equal(t, []string{"first a=1", "second a=1"}, own.messages)
+
+	global := &countingFieldLog{}
+	SetLogger(global)
+	l = With(nil, "b", 2)
+	l.Info("first")
+	l.Info("second")
+	assert.Equal(t, 1, global.with)
+
+	// The logger is created again for the new global logger.
+	changed := &countingFieldLog{}
+	SetLogger(changed)
+	l.Info("third")
+	assert.Equal(t, 1, changed.with)
+	assert.Equal(t, []string{"third b=2"}, changed.messages)
+}
+
+func Test_OrGlobal(t *testing.T) {
+	defer SetLogger(currentLogger())
+
+	global := OrGlobal(nil)
+	withFields := With(nil, "a", 1)
+
+	r := &recordingLog{}
+	SetLogger(r)
+
+	global.Info("first")
+	withFields.Info("second")
+	assert.Equal(t, []string{"first", "second a=1"}, r.messages)
+
+	SetLogger(nil)
+	global.Info("dropped")
+	withFields.Info("dropped")
+	assert.Len(t, r.messages, 2)
+
+	own := &recordingLog{}
+	assert.Same(t, own, OrGlobal(own))
+}
+
+func Test_SetLogger_Concurrent(t *testing.T) {
+	defer SetLogger(currentLogger())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetLogger(NewZeroLogLogger(zerolog.Nop()))
+		}
+	}()
+
+	l := With(nil, "a", 1)
+	for i := 0; i < 100; i++ {
+		Info("message")
+		l.Info("message")
+		assert.NotNil(t, OrGlobal(nil))
+		_ = IsGlobalSet()
+	}
+	wg.Wait()
+	assert.True(t, IsGlobalSet())
+}
@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// LevelTrace is the slog level of the trace messages.
+const LevelTrace = slog.LevelDebug - 4
+
+// SetSlogLogger sets the global logger which writes the messages to the given slog.Logger.
+func SetSlogLogger(logger *slog.Logger) {
+	SetLogger(NewSlogLogger(logger))
+}
+
+// NewSlogLogger returns the logger which writes the messages to the given slog.Logger.
+// The trace messages are logged with LevelTrace and the errors are added with the "error" key.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	return &SlogLogger{log: logger}
+}
+
+type SlogLogger struct {
+	log *slog.Logger
+}
+
+func (s SlogLogger) With(keysAndValues ...interface{}) Log {
+	return &SlogLogger{log: s.log.With(keysAndValues...)}
+}
+
+func (s SlogLogger) logf(level slog.Level, err error, msg string, args ...interface{}) {
+	ctx := context.Background()
+	if !s.log.Enabled(ctx, level) {
+		return
+	}
+
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+
+	if err != nil {
+		s.log.LogAttrs(ctx, level, msg, slog.String("error", err.Error()))
+		return
+	}
+	s.log.LogAttrs(ctx, level, msg)
+}
+
+func (s SlogLogger) Trace(msg string) {
+	s.logf(LevelTrace, nil, msg)
+}
+
+func (s SlogLogger) Tracef(msg string, args ...interface{}) {
+	s.logf(LevelTrace, nil, msg, args...)
+}
+
+func (s SlogLogger) Debug(msg string) {
+	s.logf(slog.LevelDebug, nil, msg)
+}
+
+func (s SlogLogger) Debugf(msg string, args ...interface{}) {
+	s.logf(slog.LevelDebug, nil, msg, args...)
+}
+
+func (s SlogLogger) Info(msg string) {
+	s.logf(slog.LevelInfo, nil, msg)
+}
+
+func (s SlogLogger) Infof(msg string, args ...interface{}) {
+	s.logf(slog.LevelInfo, nil, msg, args...)
+}
+
+func (s SlogLogger) Error(err error, msg string) {
+	s.logf(slog.LevelError, err, msg)
+}
+
+func (s SlogLogger) Errorf(err error, msg string, args ...interface{}) {
+	s.logf(slog.LevelError, err, msg, args...)
+}
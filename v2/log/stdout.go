@@ -29,10 +29,16 @@ func SetStdOutLogger() {
 }
 
 type StdOutLogger struct {
+	fields []interface{}
+}
+
+func (s StdOutLogger) With(keysAndValues ...interface{}) Log {
+	fields := make([]interface{}, 0, len(s.fields)+len(keysAndValues))
+	return &StdOutLogger{fields: append(append(fields, s.fields...), keysAndValues...)}
 }
 
 func (s StdOutLogger) log(level, msg string, args ...interface{}) {
-	println(fmt.Sprintf(fmt.Sprintf("%s: %s", level, msg), args...))
+	println(fmt.Sprintf(fmt.Sprintf("%s: %s", level, msg), args...) + formatFields(s.fields))
 }
 
 func (s StdOutLogger) Trace(msg string) {
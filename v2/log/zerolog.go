@@ -28,10 +28,19 @@ func SetZeroLogLogger(logger zerolog.Logger) {
 	SetLogger(&ZeroLogLogger{log: logger})
 }
 
+// NewZeroLogLogger returns the logger which writes the messages to the given zerolog.Logger.
+func NewZeroLogLogger(logger zerolog.Logger) *ZeroLogLogger {
+	return &ZeroLogLogger{log: logger}
+}
+
 type ZeroLogLogger struct {
 	log zerolog.Logger
 }
 
+func (z ZeroLogLogger) With(keysAndValues ...interface{}) Log {
+	return &ZeroLogLogger{log: z.log.With().Fields(keysAndValues).Logger()}
+}
+
 func (z ZeroLogLogger) Trace(msg string) {
 	z.log.Trace().Msgf(msg)
 }
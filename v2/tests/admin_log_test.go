@@ -145,3 +145,24 @@ func changeLogLevel(l string) string {
 
 	return "INFO"
 }
+
+// Test_Logs tests retrieval of the log entries.
+func Test_Logs(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			logs, err := client.Logs(ctx, &arangodb.LogEntriesOptions{
+				Size: utils.NewType(5),
+				Sort: "desc",
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(logs.Messages), 5)
+			require.GreaterOrEqual(t, logs.Total, len(logs.Messages))
+
+			for i := 1; i < len(logs.Messages); i++ {
+				require.Greater(t, logs.Messages[i-1].ID, logs.Messages[i].ID)
+			}
+		})
+	})
+}
@@ -89,3 +89,69 @@ func Test_Version(t *testing.T) {
 		})
 	})
 }
+
+func Test_Metrics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, time.Minute, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			metrics, err := client.Metrics(ctx)
+			require.NoError(t, err)
+
+			family, ok := metrics["arangodb_client_connection_statistics"]
+			require.True(t, ok)
+			require.Equal(t, arangodb.MetricTypeHistogram, family.Type)
+			require.NotEmpty(t, family.Metrics)
+			require.NotNil(t, family.Metrics[0].Histogram)
+			require.NotEmpty(t, family.Metrics[0].Histogram.Buckets)
+		})
+	})
+}
+
+func Test_MetricsForSingleServer(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, time.Minute, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			health, err := client.Health(ctx)
+			require.NoError(t, err)
+
+			for id, server := range health.Health {
+				if server.Role != arangodb.ServerRoleDBServer {
+					continue
+				}
+
+				metrics, err := client.MetricsForSingleServer(ctx, id)
+				require.NoError(t, err)
+				require.NotEmpty(t, metrics)
+			}
+		})
+	})
+}
+
+func Test_Statistics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, time.Minute, func(ctx context.Context, t testing.TB) {
+			statistics, err := client.Statistics(ctx)
+			require.NoError(t, err)
+			require.True(t, statistics.Enabled)
+			require.NotZero(t, statistics.Server.Uptime)
+		})
+	})
+}
+
+func Test_ShutdownInfo(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, time.Minute, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.8.1", t)
+
+			info, err := client.ShutdownInfo(ctx)
+			require.NoError(t, err)
+			require.False(t, info.SoftShutdownOngoing)
+		})
+	})
+}
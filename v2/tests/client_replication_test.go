@@ -0,0 +1,220 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_ReplicationBatchAndInventory(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					skipBelowVersion(client, ctx, "3.8", t)
+
+					batch, err := client.CreateBatch(ctx, db.Name(), 123, time.Minute)
+					require.NoError(t, err)
+					require.NotEmpty(t, batch.BatchID())
+
+					require.NoError(t, batch.Extend(ctx, time.Minute))
+
+					inventory, err := client.GetReplicationInventory(ctx, db.Name(), &arangodb.ReplicationInventoryOptions{
+						BatchID: batch.BatchID(),
+					})
+					require.NoError(t, err)
+					require.True(t, inventory.State.Running)
+					_, found := inventory.CollectionByName(col.Name())
+					require.True(t, found)
+
+					require.NoError(t, batch.Delete(ctx))
+					require.ErrorIs(t, batch.Delete(ctx), arangodb.ErrReplicationBatchClosed)
+					require.ErrorIs(t, batch.Extend(ctx, time.Minute), arangodb.ErrReplicationBatchClosed)
+				})
+			})
+		})
+	})
+}
+
+func Test_ReplicationRevisions(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					skipBelowVersion(client, ctx, "3.8", t)
+
+					noOfDocuments := 1000
+					docs := make([]UserDoc, 0, noOfDocuments)
+					for i := 0; i < noOfDocuments; i++ {
+						docs = append(docs, UserDoc{Name: fmt.Sprintf("User%d", i), Age: i})
+					}
+					_, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+
+					batch, err := client.CreateBatch(ctx, db.Name(), 123, time.Minute)
+					require.NoError(t, err)
+					defer batch.Delete(ctx)
+
+					tree, err := client.GetRevisionTree(ctx, db.Name(), batch.BatchID(), col.Name())
+					if shared.IsArangoErrorWithCode(err, http.StatusNotImplemented) {
+						t.Skip("Collection '" + col.Name() + "' does not support revision-based replication")
+					}
+					require.NoError(t, err)
+					require.Equal(t, uint64(noOfDocuments), tree.Count)
+					require.NotEmpty(t, tree.Nodes)
+
+					var resume arangodb.RevisionUInt64
+					revisions := make(arangodb.Revisions, 0, noOfDocuments)
+					for {
+						ranges, err := client.GetRevisionsByRanges(ctx, db.Name(), batch.BatchID(), col.Name(),
+							[]arangodb.RevisionMinMax{{tree.RangeMin, tree.RangeMax}}, resume)
+						require.NoError(t, err)
+						require.Len(t, ranges.Ranges, 1)
+
+						revisions = append(revisions, ranges.Ranges[0]...)
+						if ranges.Resume == 0 {
+							break
+						}
+						resume = ranges.Resume
+					}
+					require.Len(t, revisions, noOfDocuments)
+
+					documents, err := client.GetRevisionDocuments(ctx, db.Name(), batch.BatchID(), col.Name(), revisions[:10])
+					require.NoError(t, err)
+					require.Len(t, documents, 10)
+					require.Equal(t, revisions[0].String(), documents[0]["_rev"])
+				})
+			})
+		})
+	})
+}
+
+func Test_ReplicationWALTail(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					state, err := client.GetReplicationLoggerState(ctx, db.Name())
+					require.NoError(t, err)
+					require.True(t, state.State.Running)
+					require.NotEmpty(t, state.State.LastLogTick)
+
+					firstTick, err := client.GetReplicationLoggerFirstTick(ctx, db.Name())
+					require.NoError(t, err)
+					require.NotEmpty(t, firstTick)
+
+					walRange, err := client.GetWALRange(ctx, db.Name())
+					require.NoError(t, err)
+					require.NotEmpty(t, walRange.TickMax)
+
+					meta, err := col.CreateDocument(ctx, UserDoc{Name: "tailed", Age: 1})
+					require.NoError(t, err)
+
+					opts := arangodb.WALTailOptions{
+						From:       state.State.LastLogTick,
+						ClientInfo: "go-driver-test",
+					}
+
+					var found bool
+					for !found {
+						reader, err := client.TailWAL(ctx, db.Name(), &opts)
+						require.NoError(t, err)
+
+						for {
+							marker, err := reader.Read()
+							if shared.IsNoMoreDocuments(err) {
+								break
+							}
+							require.NoError(t, err)
+
+							if marker.Type == arangodb.WALMarkerTypeDocument {
+								var doc UserDocWithMeta
+								require.NoError(t, marker.DecodeData(&doc))
+								if doc.Key == meta.Key {
+									require.Equal(t, "tailed", doc.Name)
+									found = true
+								}
+							}
+						}
+
+						info := reader.Info()
+						require.NoError(t, reader.Close())
+						require.True(t, info.FromPresent)
+
+						if !found {
+							require.True(t, info.CheckMore, "document marker not found in the WAL")
+							opts.From = info.LastIncluded
+							opts.LastScanned = info.LastScanned
+						}
+					}
+				})
+			})
+		})
+	})
+}
+
+func Test_ReplicationApplier(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				state, err := client.GetReplicationApplierState(ctx, db.Name(), nil)
+				require.NoError(t, err)
+				require.False(t, state.State.Running)
+
+				config, err := client.SetReplicationApplierConfig(ctx, db.Name(), arangodb.ReplicationApplierConfig{
+					Endpoint:       "tcp://127.0.0.1:1",
+					Database:       db.Name(),
+					ChunkSize:      utils.NewType[int64](1024 * 1024),
+					IncludeSystem:  utils.NewType(false),
+					RequestTimeout: utils.NewType(10.0),
+				}, nil)
+				require.NoError(t, err)
+				require.Equal(t, "tcp://127.0.0.1:1", config.Endpoint)
+
+				config, err = client.GetReplicationApplierConfig(ctx, db.Name(), nil)
+				require.NoError(t, err)
+				require.Equal(t, db.Name(), config.Database)
+
+				state, err = client.StopReplicationApplier(ctx, db.Name(), nil)
+				require.NoError(t, err)
+				require.False(t, state.State.Running)
+			})
+		})
+	})
+}
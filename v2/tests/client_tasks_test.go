@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_Tasks(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				periodic, err := client.CreateTask(ctx, db.Name(), arangodb.TaskOptions{
+					Name:    "periodic-task",
+					Command: "(function(params) { return params.value; })(params)",
+					Params:  map[string]interface{}{"value": 1},
+					Period:  60,
+					Offset:  30,
+				})
+				require.NoError(t, err)
+				require.NotEmpty(t, periodic.ID)
+				require.Equal(t, "periodic-task", periodic.Name)
+				require.Equal(t, arangodb.TaskTypePeriodic, periodic.Type)
+				require.Equal(t, int64(60), periodic.Period)
+				require.Equal(t, db.Name(), periodic.Database)
+
+				timed, err := client.CreateTask(ctx, db.Name(), arangodb.TaskOptions{
+					ID:      GenerateUUID("task"),
+					Name:    "timed-task",
+					Command: "(function() {})()",
+					Offset:  600,
+				})
+				require.NoError(t, err)
+				require.Equal(t, arangodb.TaskTypeTimed, timed.Type)
+
+				task, err := client.Task(ctx, db.Name(), timed.ID)
+				require.NoError(t, err)
+				require.Equal(t, timed.ID, task.ID)
+				require.Equal(t, "timed-task", task.Name)
+
+				tasks, err := client.Tasks(ctx, db.Name())
+				require.NoError(t, err)
+				var ids []string
+				for _, task := range tasks {
+					ids = append(ids, task.ID)
+				}
+				require.Contains(t, ids, periodic.ID)
+				require.Contains(t, ids, timed.ID)
+
+				require.NoError(t, client.RemoveTask(ctx, db.Name(), periodic.ID))
+				require.NoError(t, client.RemoveTask(ctx, db.Name(), timed.ID))
+
+				_, err = client.Task(ctx, db.Name(), timed.ID)
+				require.True(t, shared.IsNotFound(err))
+			})
+		})
+	})
+}
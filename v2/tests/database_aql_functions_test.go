@@ -0,0 +1,90 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_AQLFunctions(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				namespace := "gotest::"
+				celsius := arangodb.AQLFunction{
+					Name:            namespace + "celsiustofahrenheit",
+					Code:            "function (celsius) { return celsius * 1.8 + 32; }",
+					IsDeterministic: true,
+				}
+
+				created, err := db.CreateAQLFunction(ctx, celsius)
+				require.NoError(t, err)
+				require.True(t, created)
+
+				created, err = db.CreateAQLFunction(ctx, celsius)
+				require.NoError(t, err)
+				require.False(t, created, "the function should be replaced")
+
+				created, err = db.CreateAQLFunction(ctx, arangodb.AQLFunction{
+					Name: namespace + "random",
+					Code: "function () { return Math.random(); }",
+				})
+				require.NoError(t, err)
+				require.True(t, created)
+
+				functions, err := db.ListAQLFunctions(ctx, namespace)
+				require.NoError(t, err)
+				require.Len(t, functions, 2)
+
+				cursor, err := db.Query(ctx, "RETURN GOTEST::CELSIUSTOFAHRENHEIT(100)", nil)
+				require.NoError(t, err)
+				var fahrenheit float64
+				_, err = cursor.ReadDocument(ctx, &fahrenheit)
+				require.NoError(t, err)
+				require.NoError(t, cursor.Close())
+				require.Equal(t, 212.0, fahrenheit)
+
+				deleted, err := db.DeleteAQLFunction(ctx, celsius.Name, false)
+				require.NoError(t, err)
+				require.Equal(t, 1, deleted)
+
+				functions, err = db.ListAQLFunctions(ctx, namespace)
+				require.NoError(t, err)
+				require.Len(t, functions, 1)
+				require.Equal(t, namespace+"random", functions[0].Name)
+				require.False(t, functions[0].IsDeterministic)
+
+				deleted, err = db.DeleteAQLFunction(ctx, namespace, true)
+				require.NoError(t, err)
+				require.Equal(t, 1, deleted)
+
+				functions, err = db.ListAQLFunctions(ctx, namespace)
+				require.NoError(t, err)
+				require.Empty(t, functions)
+			})
+		})
+	})
+}
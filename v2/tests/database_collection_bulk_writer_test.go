@@ -0,0 +1,120 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_BulkWriter(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					docs := make([]DocWithRev, 50)
+					for i := range docs {
+						docs[i] = DocWithRev{Key: fmt.Sprintf("doc_%02d", i), Name: "created", Age: utils.NewType(i)}
+					}
+					// The duplicate key fails only its own document.
+					docs[30].Key = docs[10].Key
+
+					t.Run("Create", func(t *testing.T) {
+						result, err := arangodb.NewBulkWriter[DocWithRev](col, &arangodb.BulkWriterOptions{
+							BatchSize: 7,
+							Workers:   3,
+						}).Write(ctx, docs)
+						require.NoError(t, err)
+						require.Len(t, result.Documents, 50)
+						require.Equal(t, 1, result.Errors)
+
+						for i, r := range result.Documents {
+							require.Equal(t, i, r.Index)
+							if i == 30 {
+								require.True(t, shared.IsArangoErrorWithErrorNum(r.Err, shared.ErrArangoUniqueConstraintViolated))
+								continue
+							}
+							require.NoError(t, r.Err)
+							require.Equal(t, docs[i].Key, r.Key)
+						}
+
+						count, err := col.Count(ctx)
+						require.NoError(t, err)
+						require.EqualValues(t, 49, count)
+					})
+
+					t.Run("Create with overwrite mode", func(t *testing.T) {
+						input := make(chan DocWithRev)
+						go func() {
+							defer close(input)
+							for _, doc := range docs[:20] {
+								doc.Name = "overwritten"
+								input <- doc
+							}
+						}()
+
+						result, err := arangodb.NewBulkWriter[DocWithRev](col, &arangodb.BulkWriterOptions{
+							BatchSize:     6,
+							WaitForSync:   utils.NewType(true),
+							OverwriteMode: utils.NewType(arangodb.CollectionDocumentCreateOverwriteModeReplace),
+						}).WriteChannel(ctx, input)
+						require.NoError(t, err)
+						require.Len(t, result.Documents, 20)
+						require.Equal(t, 0, result.Errors)
+
+						var doc DocWithRev
+						_, err = col.ReadDocument(ctx, docs[5].Key, &doc)
+						require.NoError(t, err)
+						require.Equal(t, "overwritten", doc.Name)
+					})
+
+					t.Run("Replace", func(t *testing.T) {
+						// The documents without the age attribute remove it, in contrast to the update.
+						replaced := []map[string]interface{}{
+							{"_key": docs[0].Key, "name": "replaced"},
+							{"_key": "missing", "name": "replaced"},
+						}
+
+						result, err := arangodb.NewBulkWriter[map[string]interface{}](col, &arangodb.BulkWriterOptions{
+							Operation: arangodb.BulkWriteOperationReplace,
+						}).Write(ctx, replaced)
+						require.NoError(t, err)
+						require.Equal(t, 1, result.Errors)
+						require.True(t, shared.IsNotFound(result.Documents[1].Err))
+
+						var doc DocWithRev
+						_, err = col.ReadDocument(ctx, docs[0].Key, &doc)
+						require.NoError(t, err)
+						require.Equal(t, "replaced", doc.Name)
+						require.Nil(t, doc.Age)
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,143 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_DatabaseCollectionDocImport(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					t.Run("Slice in batches", func(t *testing.T) {
+						docs := make([]UserDocWithMeta, 100)
+						for i := range docs {
+							docs[i].Key = fmt.Sprintf("slice-%d", i)
+							docs[i].Name = docs[i].Key
+							docs[i].Age = i
+						}
+
+						stats, err := col.ImportDocuments(ctx, docs, &arangodb.CollectionDocumentImportOptions{
+							MaxBatchSize: 256,
+						})
+						require.NoError(t, err)
+						require.Equal(t, int64(len(docs)), stats.Created)
+						require.Zero(t, stats.Errors)
+
+						var doc UserDoc
+						_, err = col.ReadDocument(ctx, "slice-42", &doc)
+						require.NoError(t, err)
+						require.Equal(t, 42, doc.Age)
+					})
+
+					t.Run("JSON Lines with errors", func(t *testing.T) {
+						input := strings.Join([]string{
+							`{"_key": "lines-1", "name": "a"}`,
+							``,
+							`{"_key": "slice-1", "name": "duplicate"}`,
+							`{"_key": "lines-2", "name": "b"}`,
+						}, "\n")
+
+						// Each document is sent in a separate batch, so the duplicate is reported at position 0 of its batch.
+						stats, err := col.ImportDocumentsFromReader(ctx, strings.NewReader(input), &arangodb.CollectionDocumentImportOptions{
+							Details:      utils.NewType(true),
+							MaxBatchSize: 40,
+						})
+						require.NoError(t, err)
+						require.Equal(t, int64(2), stats.Created)
+						require.Equal(t, int64(1), stats.Errors)
+						require.Equal(t, int64(1), stats.Empty)
+						require.Len(t, stats.LineErrors, 1)
+						require.Equal(t, int64(3), stats.LineErrors[0].Line)
+						require.Contains(t, stats.LineErrors[0].Message, "unique constraint violated")
+					})
+
+					t.Run("JSON array with onDuplicate", func(t *testing.T) {
+						input := `[
+							{"_key": "slice-2", "name": "updated"},
+							{"_key": "array-1", "name": "new"}
+						]`
+
+						stats, err := col.ImportDocumentsFromReader(ctx, strings.NewReader(input), &arangodb.CollectionDocumentImportOptions{
+							OnDuplicate: arangodb.CollectionDocumentImportOnDuplicateUpdate,
+						})
+						require.NoError(t, err)
+						require.Equal(t, int64(1), stats.Created)
+						require.Equal(t, int64(1), stats.Updated)
+
+						var doc UserDoc
+						_, err = col.ReadDocument(ctx, "slice-2", &doc)
+						require.NoError(t, err)
+						require.Equal(t, "updated", doc.Name)
+						require.Equal(t, 2, doc.Age)
+					})
+
+					t.Run("Channel with complete", func(t *testing.T) {
+						documents := make(chan interface{})
+						go func() {
+							defer close(documents)
+							documents <- UserDocWithMeta{DocumentMeta: arangodb.DocumentMeta{Key: "channel-1"}}
+							documents <- UserDocWithMeta{DocumentMeta: arangodb.DocumentMeta{Key: "slice-3"}}
+						}()
+
+						_, err := col.ImportDocumentsFromChannel(ctx, documents, &arangodb.CollectionDocumentImportOptions{
+							Complete: utils.NewType(true),
+						})
+						require.Error(t, err)
+
+						exists, err := col.DocumentExists(ctx, "channel-1")
+						require.NoError(t, err)
+						require.False(t, exists)
+					})
+
+					t.Run("Overwrite", func(t *testing.T) {
+						documents := make(chan interface{}, 3)
+						for i := 0; i < 3; i++ {
+							documents <- UserDoc{Name: fmt.Sprintf("overwrite-%d", i)}
+						}
+						close(documents)
+
+						stats, err := col.ImportDocumentsFromChannel(ctx, documents, &arangodb.CollectionDocumentImportOptions{
+							Overwrite:    utils.NewType(true),
+							MaxBatchSize: 1,
+						})
+						require.NoError(t, err)
+						require.Equal(t, int64(3), stats.Created)
+
+						count, err := col.Count(ctx)
+						require.NoError(t, err)
+						require.Equal(t, int64(3), count)
+					})
+				})
+			})
+		})
+	})
+}
@@ -509,3 +509,109 @@ func Test_DatabaseCollectionTruncate(t *testing.T) {
 		})
 	})
 }
+
+func Test_CollectionStatisticsAndChecksum(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					size := 10
+					_, err := col.CreateDocuments(ctx, newDocs(size))
+					require.NoError(t, err)
+
+					stats, err := col.Statistics(ctx, true)
+					require.NoError(t, err)
+					require.Equal(t, int64(size), stats.Count)
+
+					revision, err := col.Revision(ctx)
+					require.NoError(t, err)
+					require.NotEmpty(t, revision)
+
+					checksum, err := col.Checksum(ctx, true, true)
+					require.NoError(t, err)
+					require.NotEmpty(t, checksum.Checksum)
+					require.Equal(t, col.Name(), checksum.Name)
+
+					_, err = col.CreateDocuments(ctx, newDocs(1))
+					require.NoError(t, err)
+
+					changed, err := col.Checksum(ctx, true, true)
+					require.NoError(t, err)
+					require.NotEqual(t, checksum.Checksum, changed.Checksum)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionMaintenance(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					_, err := col.CreateDocuments(ctx, newDocs(10))
+					require.NoError(t, err)
+
+					info, err := col.Compact(ctx)
+					require.NoError(t, err)
+					require.Equal(t, col.Name(), info.Name)
+
+					require.NoError(t, col.RecalculateCount(ctx))
+					require.NoError(t, col.LoadIndexesIntoMemory(ctx))
+
+					count, err := col.Count(ctx)
+					require.NoError(t, err)
+					require.Equal(t, int64(10), count)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionRename(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					newName := GenerateUUID("test-COL-renamed")
+
+					renamed, err := col.Rename(ctx, newName)
+					require.NoError(t, err)
+					require.Equal(t, newName, renamed.Name())
+
+					exists, err := db.CollectionExists(ctx, col.Name())
+					require.NoError(t, err)
+					require.False(t, exists)
+
+					_, err = renamed.CreateDocument(ctx, UserDoc{Name: "renamed", Age: 1})
+					require.NoError(t, err)
+				})
+			})
+		})
+	})
+}
+
+func Test_CollectionResponsibleShard(t *testing.T) {
+	requireClusterMode(t)
+
+	options := arangodb.CreateCollectionProperties{
+		NumberOfShards: 3,
+	}
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, &options, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					shards, err := col.Shards(ctx, false)
+					require.NoError(t, err)
+
+					shardID, err := col.ResponsibleShard(ctx, map[string]interface{}{"_key": "some-key"})
+					require.NoError(t, err)
+					require.Contains(t, shards.Shards, shardID)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_TypedCollection(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					typed := arangodb.NewTypedCollection[DocWithRev](col)
+
+					docs := []DocWithRev{
+						{Key: "a", Name: "first", Age: utils.NewType(1)},
+						{Key: "b", Name: "second", Age: utils.NewType(2)},
+						{Key: "a", Name: "duplicate"},
+					}
+
+					t.Run("CreateMany", func(t *testing.T) {
+						responses, err := typed.CreateManyWithOptions(ctx, docs, &arangodb.CollectionDocumentCreateOptions{
+							NewObject: &DocWithRev{},
+						})
+						require.NoError(t, err)
+						require.Len(t, responses, 3)
+
+						require.Equal(t, "a", responses[0].Key)
+						require.NotNil(t, responses[0].New)
+						require.Equal(t, "first", responses[0].New.Name)
+						require.Equal(t, responses[0].Rev, responses[0].New.Rev)
+
+						require.Equal(t, "b", responses[1].Key)
+						require.NotNil(t, responses[1].New)
+						require.Equal(t, "second", responses[1].New.Name)
+
+						require.True(t, shared.IsArangoErrorWithErrorNum(responses[2].AsArangoError(), shared.ErrArangoUniqueConstraintViolated))
+						require.Nil(t, responses[2].New)
+					})
+
+					t.Run("Create", func(t *testing.T) {
+						response, err := typed.Create(ctx, DocWithRev{Key: "c", Name: "third"})
+						require.NoError(t, err)
+						require.Equal(t, "c", response.Key)
+						require.Nil(t, response.New)
+					})
+
+					t.Run("Read", func(t *testing.T) {
+						doc, meta, err := typed.Read(ctx, "a")
+						require.NoError(t, err)
+						require.Equal(t, "a", meta.Key)
+						require.Equal(t, "first", doc.Name)
+						require.Equal(t, 1, *doc.Age)
+
+						_, _, err = typed.Read(ctx, "missing")
+						require.True(t, shared.IsNotFound(err))
+					})
+
+					t.Run("ReadMany", func(t *testing.T) {
+						responses, err := typed.ReadMany(ctx, []string{"b", "missing", "c"})
+						require.NoError(t, err)
+						require.Len(t, responses, 3)
+						require.Equal(t, "second", responses[0].Document.Name)
+						require.True(t, shared.IsNotFound(responses[1].AsArangoError()))
+						require.Equal(t, "third", responses[2].Document.Name)
+					})
+
+					t.Run("Query", func(t *testing.T) {
+						cursor, err := arangodb.Query[DocWithRev](ctx, db, "FOR d IN @@col SORT d._key RETURN d", &arangodb.QueryOptions{
+							BindVars:  map[string]interface{}{"@col": col.Name()},
+							BatchSize: 1,
+						})
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						first, meta, err := cursor.Read(ctx)
+						require.NoError(t, err)
+						require.Equal(t, "a", meta.Key)
+						require.Equal(t, "first", first.Name)
+
+						rest, err := cursor.ReadAll(ctx)
+						require.NoError(t, err)
+						require.Len(t, rest, 2)
+						require.Equal(t, "second", rest[0].Name)
+						require.Equal(t, "third", rest[1].Name)
+						require.False(t, cursor.HasMore())
+					})
+
+					t.Run("Query iterator", func(t *testing.T) {
+						cursor, err := arangodb.Query[DocWithRev](ctx, db, "FOR d IN @@col SORT d._key RETURN d", &arangodb.QueryOptions{
+							BindVars:  map[string]interface{}{"@col": col.Name()},
+							BatchSize: 1,
+						})
+						require.NoError(t, err)
+
+						var names []string
+						for doc, err := range cursor.All(ctx) {
+							require.NoError(t, err)
+							names = append(names, doc.Name)
+							if len(names) == 2 {
+								break
+							}
+						}
+						require.Equal(t, []string{"first", "second"}, names)
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_DatabaseExport(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, tb testing.TB) {
+					docs := make([]UserDoc, 25)
+					for i := range docs {
+						docs[i] = UserDoc{Name: fmt.Sprintf("name_%02d", i), Age: i}
+					}
+					_, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+
+					t.Run("NDJSON", func(t *testing.T) {
+						var out bytes.Buffer
+						var batches int
+						progress, err := db.ExportCollection(ctx, col.Name(), &arangodb.ExportOptions{
+							Query: &arangodb.QueryOptions{BatchSize: 10},
+							Progress: func(p arangodb.ExportProgress) {
+								batches = p.Batches
+							},
+						}, &out)
+						require.NoError(t, err)
+						require.True(t, progress.Done)
+						require.Equal(t, 3, batches)
+						require.EqualValues(t, 25, progress.Documents)
+						require.EqualValues(t, out.Len(), progress.Bytes)
+						require.Len(t, strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n"), 25)
+					})
+
+					t.Run("CSV", func(t *testing.T) {
+						var out bytes.Buffer
+						progress, err := db.ExportQuery(ctx, "FOR d IN @@col SORT d.age LIMIT 2 RETURN d", &arangodb.ExportOptions{
+							Format:  arangodb.ExportFormatCSV,
+							Columns: []arangodb.ExportColumn{{Name: "name"}, {Name: "age"}},
+							Query: &arangodb.QueryOptions{
+								BindVars: map[string]interface{}{"@col": col.Name()},
+							},
+						}, &out)
+						require.NoError(t, err)
+						require.True(t, progress.Done)
+						require.Equal(t, "name,age\nname_00,0\nname_01,1\n", out.String())
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,209 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+const foxxTestManifest = `{
+  "name": "go-driver-test",
+  "version": "1.0.0",
+  "main": "index.js",
+  "configuration": {
+    "greeting": {"type": "string", "default": "hello", "required": false}
+  },
+  "scripts": {
+    "echo": "echo.js"
+  }
+}`
+
+const foxxTestMain = `'use strict';
+const createRouter = require('@arangodb/foxx/router');
+const router = createRouter();
+module.context.use(router);
+router.get('/greeting', function (req, res) {
+  res.json({greeting: module.context.configuration.greeting});
+});
+`
+
+const foxxTestScript = `'use strict';
+module.exports = module.context.argv[0];
+`
+
+func newFoxxTestBundle(t testing.TB, version string) *bytes.Buffer {
+	var buf bytes.Buffer
+
+	w := zip.NewWriter(&buf)
+	files := map[string]string{
+		"manifest.json": foxxTestManifest,
+		"index.js":      foxxTestMain,
+		"echo.js":       foxxTestScript,
+		"README.md":     "# go-driver-test " + version,
+	}
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return &buf
+}
+
+func Test_DatabaseFoxx(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				mount := "/go-driver-test"
+
+				service, err := db.InstallFoxxService(ctx, mount, arangodb.NewFoxxServiceSourceZip(newFoxxTestBundle(t, "1")), nil)
+				require.NoError(t, err)
+				require.Equal(t, mount, service.Mount)
+				require.Equal(t, "go-driver-test", service.Name)
+
+				t.Run("Install twice", func(t *testing.T) {
+					_, err := db.InstallFoxxService(ctx, mount, arangodb.NewFoxxServiceSourceZip(newFoxxTestBundle(t, "1")), nil)
+					require.Error(t, err)
+				})
+
+				t.Run("Invalid source", func(t *testing.T) {
+					_, err := db.InstallFoxxService(ctx, "/invalid", arangodb.FoxxServiceSource{}, nil)
+					require.True(t, shared.IsInvalidArgument(err))
+				})
+
+				t.Run("List", func(t *testing.T) {
+					services, err := db.ListFoxxServices(ctx, &arangodb.FoxxListOptions{ExcludeSystem: utils.NewType(true)})
+					require.NoError(t, err)
+					require.Len(t, services, 1)
+					require.Equal(t, mount, services[0].Mount)
+				})
+
+				t.Run("Get", func(t *testing.T) {
+					details, err := db.GetFoxxService(ctx, mount)
+					require.NoError(t, err)
+					require.Equal(t, "1.0.0", details.Version)
+					require.NotEmpty(t, details.Checksum)
+				})
+
+				t.Run("Configuration", func(t *testing.T) {
+					config, err := db.GetFoxxServiceConfiguration(ctx, mount)
+					require.NoError(t, err)
+					require.Contains(t, config, "greeting")
+					require.Equal(t, "hello", config["greeting"].Current)
+
+					result, err := db.UpdateFoxxServiceConfiguration(ctx, mount, map[string]interface{}{"greeting": "hi"})
+					require.NoError(t, err)
+					require.Equal(t, "hi", result.Values["greeting"])
+
+					result, err = db.ReplaceFoxxServiceConfiguration(ctx, mount, map[string]interface{}{})
+					require.NoError(t, err)
+					require.Equal(t, "hello", result.Values["greeting"])
+				})
+
+				t.Run("Dependencies", func(t *testing.T) {
+					dependencies, err := db.GetFoxxServiceDependencies(ctx, mount)
+					require.NoError(t, err)
+					require.Empty(t, dependencies)
+				})
+
+				t.Run("Scripts", func(t *testing.T) {
+					scripts, err := db.ListFoxxServiceScripts(ctx, mount)
+					require.NoError(t, err)
+					require.Contains(t, scripts, "echo")
+
+					var result string
+					err = db.RunFoxxServiceScript(ctx, mount, "echo", "ping", &result)
+					require.NoError(t, err)
+					require.Equal(t, "ping", result)
+				})
+
+				t.Run("Tests", func(t *testing.T) {
+					var report arangodb.FoxxServiceTestsReport
+					err := db.RunFoxxServiceTests(ctx, mount, nil, &report)
+					require.NoError(t, err)
+					require.Equal(t, 0, report.Stats.Failures)
+				})
+
+				t.Run("Development mode", func(t *testing.T) {
+					details, err := db.EnableFoxxDevelopmentMode(ctx, mount)
+					require.NoError(t, err)
+					require.True(t, details.Development)
+
+					details, err = db.DisableFoxxDevelopmentMode(ctx, mount)
+					require.NoError(t, err)
+					require.False(t, details.Development)
+				})
+
+				t.Run("Readme and swagger", func(t *testing.T) {
+					readme, err := db.GetFoxxServiceReadme(ctx, mount)
+					require.NoError(t, err)
+					require.Equal(t, "# go-driver-test 1", readme)
+
+					swagger, err := db.GetFoxxServiceSwagger(ctx, mount)
+					require.NoError(t, err)
+					require.Contains(t, swagger.Paths, "/greeting")
+				})
+
+				t.Run("Download", func(t *testing.T) {
+					bundle, err := db.DownloadFoxxServiceBundle(ctx, mount)
+					require.NoError(t, err)
+
+					_, err = zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+					require.NoError(t, err)
+				})
+
+				t.Run("Upgrade and replace", func(t *testing.T) {
+					_, err := db.UpgradeFoxxService(ctx, mount, arangodb.NewFoxxServiceSourceZip(newFoxxTestBundle(t, "2")), nil)
+					require.NoError(t, err)
+
+					readme, err := db.GetFoxxServiceReadme(ctx, mount)
+					require.NoError(t, err)
+					require.Equal(t, "# go-driver-test 2", readme)
+
+					_, err = db.ReplaceFoxxService(ctx, mount, arangodb.NewFoxxServiceSourceZip(newFoxxTestBundle(t, "3")), nil)
+					require.NoError(t, err)
+
+					readme, err = db.GetFoxxServiceReadme(ctx, mount)
+					require.NoError(t, err)
+					require.Equal(t, "# go-driver-test 3", readme)
+				})
+
+				err = db.UninstallFoxxService(ctx, mount, &arangodb.FoxxUninstallOptions{Teardown: utils.NewType(true)})
+				require.NoError(t, err)
+
+				_, err = db.GetFoxxService(ctx, mount)
+				require.Error(t, err)
+				require.True(t, shared.IsNotFound(err))
+			})
+		})
+	})
+}
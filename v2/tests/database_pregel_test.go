@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/utils"
+)
+
+func Test_DatabasePregelJob(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			skipBelowVersion(client, ctx, "3.10", t)
+			skipFromVersion(client, ctx, "3.12", t)
+		})
+
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				vertexName := db.Name() + "_pregel_vertex"
+				edgeName := db.Name() + "_pregel_edge"
+
+				vertices, err := db.CreateCollection(ctx, vertexName, &arangodb.CreateCollectionProperties{
+					NumberOfShards: 4,
+				})
+				require.NoError(t, err)
+
+				edges, err := db.CreateCollection(ctx, edgeName, &arangodb.CreateCollectionProperties{
+					Type:                 arangodb.CollectionTypeEdge,
+					NumberOfShards:       4,
+					ShardKeys:            []string{"vertex"},
+					DistributeShardsLike: vertexName,
+				})
+				require.NoError(t, err)
+
+				g, err := db.CreateGraph(ctx, db.Name()+"_pregel_graph", &arangodb.GraphDefinition{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{Collection: edgeName, From: []string{vertexName}, To: []string{vertexName}},
+					},
+				}, nil)
+				require.NoError(t, err)
+
+				a, err := vertices.CreateDocument(ctx, UserDoc{Name: "Jan", Age: 12})
+				require.NoError(t, err)
+				b, err := vertices.CreateDocument(ctx, UserDoc{Name: "Jane", Age: 21})
+				require.NoError(t, err)
+
+				_, err = edges.CreateDocument(ctx, map[string]interface{}{
+					"_from":  string(a.ID),
+					"_to":    string(b.ID),
+					"vertex": a.Key,
+				})
+				require.NoError(t, err)
+
+				jobID, err := db.StartPregelJob(ctx, arangodb.PregelJobOptions{
+					GraphName: g.Name(),
+					Params: arangodb.PregelPageRankParams{
+						PregelParams: arangodb.PregelParams{
+							Store:       utils.NewType(true),
+							ResultField: "rank",
+						},
+					},
+				})
+				require.NoError(t, err)
+				require.NotEmpty(t, jobID)
+
+				jobs, err := db.GetPregelJobs(ctx)
+				require.NoError(t, err)
+				require.NotEmpty(t, jobs)
+
+				progress := 0
+				job, err := db.WaitForPregelJob(ctx, jobID, &arangodb.PregelWaitOptions{
+					Interval: 100 * time.Millisecond,
+					OnProgress: func(job arangodb.PregelJob) {
+						progress++
+					},
+				})
+				require.NoError(t, err)
+				require.Equal(t, arangodb.PregelJobStateDone, job.State)
+				require.Equal(t, arangodb.PregelAlgorithmPageRank, job.Algorithm)
+				require.Greater(t, progress, 0)
+
+				var result struct {
+					UserDoc
+					Rank float64 `json:"rank"`
+				}
+				_, err = vertices.ReadDocument(ctx, b.Key, &result)
+				require.NoError(t, err)
+				require.Greater(t, result.Rank, 0.0)
+			})
+		})
+	})
+}
+
+func Test_DatabasePregelJobCancel(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			skipFromVersion(client, ctx, "3.12", t)
+		})
+
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				_, err := db.StartPregelJob(ctx, arangodb.PregelJobOptions{})
+				require.Error(t, err)
+
+				err = db.CancelPregelJob(ctx, "9999999")
+				require.Error(t, err)
+			})
+		})
+	})
+}
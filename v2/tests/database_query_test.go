@@ -24,10 +24,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/utils"
 )
 
 // Test_ExplainQuery tries to explain several AQL queries.
@@ -228,3 +231,166 @@ func Test_QueryBatchWithRetries(t *testing.T) {
 		})
 	})
 }
+
+func Test_AQLQueryProperties(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				properties, err := db.GetAQLQueryProperties(ctx)
+				require.NoError(t, err)
+				require.NotNil(t, properties.Enabled)
+				require.NotNil(t, properties.MaxSlowQueries)
+
+				updated, err := db.UpdateAQLQueryProperties(ctx, arangodb.QueryProperties{
+					MaxSlowQueries:       utils.NewType(*properties.MaxSlowQueries + 1),
+					MaxQueryStringLength: utils.NewType(2048),
+				})
+				require.NoError(t, err)
+				require.Equal(t, *properties.MaxSlowQueries+1, *updated.MaxSlowQueries)
+				require.Equal(t, 2048, *updated.MaxQueryStringLength)
+				require.Equal(t, *properties.Enabled, *updated.Enabled)
+
+				_, err = db.UpdateAQLQueryProperties(ctx, properties)
+				require.NoError(t, err)
+			})
+		})
+	})
+}
+
+func Test_AQLQueryKill(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				query := "RETURN SLEEP(20)"
+
+				done := make(chan error, 1)
+				go func() {
+					cursor, err := db.Query(ctx, query, nil)
+					if err == nil {
+						cursor.Close()
+					}
+					done <- err
+				}()
+
+				var running arangodb.RunningAQLQuery
+				NewTimeout(func() error {
+					queries, err := db.ListOfRunningAQLQueries(ctx, nil)
+					if err != nil {
+						return err
+					}
+
+					for _, q := range queries {
+						if q.Query == query {
+							running = q
+							return Interrupt{}
+						}
+					}
+					return nil
+				}).TimeoutT(t, 10*time.Second, 125*time.Millisecond)
+
+				require.NotEmpty(t, running.ID)
+				require.Equal(t, db.Name(), running.Database)
+
+				require.NoError(t, db.KillAQLQuery(ctx, running.ID, nil))
+
+				select {
+				case err := <-done:
+					require.Error(t, err)
+				case <-time.After(10 * time.Second):
+					t.Fatal("the query has not been killed")
+				}
+
+				err := db.KillAQLQuery(ctx, running.ID, nil)
+				require.True(t, shared.IsNotFound(err))
+			})
+		})
+	})
+}
+
+func Test_AQLSlowQueries(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				properties, err := db.GetAQLQueryProperties(ctx)
+				require.NoError(t, err)
+				defer db.UpdateAQLQueryProperties(ctx, properties)
+
+				_, err = db.UpdateAQLQueryProperties(ctx, arangodb.QueryProperties{
+					Enabled:            utils.NewType(true),
+					TrackSlowQueries:   utils.NewType(true),
+					SlowQueryThreshold: utils.NewType(0.1),
+				})
+				require.NoError(t, err)
+
+				require.NoError(t, db.ClearSlowAQLQueries(ctx, nil))
+
+				query := "RETURN SLEEP(0.5)"
+				cursor, err := db.Query(ctx, query, nil)
+				require.NoError(t, err)
+				require.NoError(t, cursor.Close())
+
+				queries, err := db.ListOfSlowAQLQueries(ctx, nil)
+				require.NoError(t, err)
+				require.Len(t, queries, 1)
+				require.Equal(t, query, queries[0].Query)
+				require.GreaterOrEqual(t, queries[0].RunTime, 0.1)
+
+				require.NoError(t, db.ClearSlowAQLQueries(ctx, nil))
+
+				queries, err = db.ListOfSlowAQLQueries(ctx, nil)
+				require.NoError(t, err)
+				require.Empty(t, queries)
+			})
+		})
+	})
+}
+
+func Test_AQLQueryCache(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					properties, err := db.GetQueryCacheProperties(ctx)
+					require.NoError(t, err)
+					require.NotEmpty(t, properties.Mode)
+					defer db.SetQueryCacheProperties(ctx, properties)
+
+					updated, err := db.SetQueryCacheProperties(ctx, arangodb.QueryCacheProperties{
+						Mode:       arangodb.QueryCacheModeDemand,
+						MaxResults: utils.NewType[uint64](64),
+					})
+					require.NoError(t, err)
+					require.Equal(t, arangodb.QueryCacheModeDemand, updated.Mode)
+					require.Equal(t, uint64(64), *updated.MaxResults)
+
+					_, err = col.CreateDocuments(ctx, []UserDoc{{Name: "John", Age: 13}, {Name: "Jake", Age: 25}})
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR u IN `%s` RETURN u", col.Name())
+					for _, cached := range []bool{false, true} {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{Cache: true})
+						require.NoError(t, err)
+						require.Equal(t, cached, cursor.Cached())
+						require.NoError(t, cursor.Close())
+					}
+
+					entries, err := db.GetQueryCacheEntries(ctx)
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					require.Equal(t, query, entries[0].Query)
+					require.Equal(t, uint64(2), entries[0].Results)
+					require.Equal(t, uint64(1), entries[0].Hits)
+					require.Contains(t, entries[0].DataSources, col.Name())
+
+					require.NoError(t, db.ClearQueryCache(ctx))
+
+					entries, err = db.GetQueryCacheEntries(ctx)
+					require.NoError(t, err)
+					require.Empty(t, entries)
+				})
+			})
+		})
+	})
+}
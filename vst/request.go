@@ -53,6 +53,11 @@ func (r *vstRequest) Method() string {
 	return r.method
 }
 
+// GetBody returns the encoded body of the Request.
+func (r *vstRequest) GetBody() []byte {
+	return r.bodyBuilder.GetBody()
+}
+
 // Clone creates a new request containing the same data as this request
 func (r *vstRequest) Clone() driver.Request {
 	clone := *r